@@ -1,11 +1,13 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/knullci/necrosword/internal/app"
 	"github.com/knullci/necrosword/internal/config"
+	grpcserver "github.com/knullci/necrosword/internal/grpc"
 	"github.com/spf13/cobra"
 )
 
@@ -27,6 +29,9 @@ for the Knull CI/CD platform.`,
 		},
 	}
 
+	var configType string
+	rootCmd.PersistentFlags().StringVar(&configType, "config-type", "", "Config file format (yaml, toml, json); detected from the file extension by default")
+
 	// Version command
 	versionCmd := &cobra.Command{
 		Use:   "version",
@@ -43,7 +48,7 @@ for the Knull CI/CD platform.`,
 		Use:   "server",
 		Short: "Start the Necrosword gRPC server",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cfg, err := config.Load()
+			cfg, err := config.Load(configType)
 			if err != nil {
 				return fmt.Errorf("failed to load config: %w", err)
 			}
@@ -62,17 +67,23 @@ for the Knull CI/CD platform.`,
 		Use:   "execute",
 		Short: "Execute a single command",
 		Example: `  necrosword execute --tool git --args "clone,https://github.com/user/repo.git"
-  necrosword execute --tool npm --args "install" --workdir /path/to/project`,
+  necrosword execute --tool npm --args "install" --workdir /path/to/project
+  necrosword execute --tool npm --args "test" --stream --output ndjson`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			tool, _ := cmd.Flags().GetString("tool")
 			cmdArgs, _ := cmd.Flags().GetString("args")
 			workdir, _ := cmd.Flags().GetString("workdir")
+			stream, _ := cmd.Flags().GetBool("stream")
+			output, _ := cmd.Flags().GetString("output")
 
 			if tool == "" {
 				return fmt.Errorf("tool is required")
 			}
+			if output != app.OutputText && !stream {
+				return fmt.Errorf("--output %s requires --stream", output)
+			}
 
-			cfg, err := config.Load()
+			cfg, err := config.Load(configType)
 			if err != nil {
 				return fmt.Errorf("failed to load config: %w", err)
 			}
@@ -82,17 +93,97 @@ for the Knull CI/CD platform.`,
 				return fmt.Errorf("failed to create application: %w", err)
 			}
 
+			if stream {
+				// The CLI exits with the command's exit code, set in main
+				err := application.ExecuteCommandStream(tool, cmdArgs, workdir, output)
+				if errors.As(err, new(*app.ExitError)) {
+					cmd.SilenceUsage = true
+					cmd.SilenceErrors = true
+				}
+				return err
+			}
 			return application.ExecuteCommand(tool, cmdArgs, workdir)
 		},
 	}
 
 	executeCmd.Flags().StringP("tool", "t", "", "Tool to execute (git, npm, mvn, docker, kubectl)")
+	executeCmd.RegisterFlagCompletionFunc("tool", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		cfg, err := config.Load(configType)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		// Pattern entries match many tools and cannot be offered
+		var tools []string
+		for _, entry := range cfg.Executor.AllowedTools {
+			if !config.IsToolPattern(entry) {
+				tools = append(tools, entry)
+			}
+		}
+		return tools, cobra.ShellCompDirectiveNoFileComp
+	})
 	executeCmd.Flags().StringP("args", "a", "", "Comma-separated arguments")
 	executeCmd.Flags().StringP("workdir", "w", ".", "Working directory")
+	executeCmd.Flags().Bool("stream", false, "Print output as it is produced")
+	executeCmd.Flags().StringP("output", "o", app.OutputText, "Stream output format (text, ndjson)")
+
+	executeCmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions([]string{app.OutputText, app.OutputNDJSON}, cobra.ShellCompDirectiveNoFileComp))
+	executeCmd.MarkFlagDirname("workdir")
+
+	// Completion command - generates shell completion scripts
+	completionCmd := &cobra.Command{
+		Use:   "completion [bash|zsh|fish|powershell]",
+		Short: "Generate a shell completion script",
+		Example: `  source <(necrosword completion bash)
+  necrosword completion zsh > "${fpath[1]}/_necrosword"
+  necrosword completion fish > ~/.config/fish/completions/necrosword.fish
+  necrosword completion powershell | Out-String | Invoke-Expression`,
+		ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			switch args[0] {
+			case "bash":
+				return rootCmd.GenBashCompletionV2(out, true)
+			case "zsh":
+				return rootCmd.GenZshCompletion(out)
+			case "fish":
+				return rootCmd.GenFishCompletion(out, true)
+			default:
+				return rootCmd.GenPowerShellCompletionWithDesc(out)
+			}
+		},
+	}
+
+	// Isolate command - sets up a command's mounts for namespace isolation;
+	// run by the server itself, not meant for direct use
+	isolateCmd := &cobra.Command{
+		Use:    grpcserver.IsolateCommand + " -- command [args...]",
+		Hidden: true,
+		Args:   cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			root, _ := cmd.Flags().GetString("root")
+			mounts, _ := cmd.Flags().GetStringArray("bind")
+			workDir, _ := cmd.Flags().GetString("workdir")
+
+			err := grpcserver.ExecIsolated(root, workDir, mounts, args)
+			fmt.Fprintf(os.Stderr, "necrosword: isolation failed: %v\n", err)
+			os.Exit(127)
+		},
+	}
+	isolateCmd.Flags().String("root", "", "Root directory of the command")
+	isolateCmd.Flags().StringArray("bind", nil, "Bind mount (src[:dst][:ro]), repeatable")
+	isolateCmd.Flags().String("workdir", "/", "Working directory inside the root")
+	isolateCmd.MarkFlagRequired("root")
 
-	rootCmd.AddCommand(versionCmd, serverCmd, executeCmd)
+	rootCmd.AddCommand(versionCmd, serverCmd, executeCmd, completionCmd, isolateCmd)
 
 	if err := rootCmd.Execute(); err != nil {
+		var exitErr *app.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
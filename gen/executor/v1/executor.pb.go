@@ -22,6 +22,555 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// WorkDirCleanup controls removal of a server-created working directory
+type WorkDirCleanup int32
+
+const (
+	// Leave the directory in place
+	WorkDirCleanup_WORK_DIR_CLEANUP_UNSPECIFIED WorkDirCleanup = 0
+	// Remove the directory when the command succeeds
+	WorkDirCleanup_WORK_DIR_CLEANUP_ON_SUCCESS WorkDirCleanup = 1
+	// Remove the directory when the command fails
+	WorkDirCleanup_WORK_DIR_CLEANUP_ON_FAILURE WorkDirCleanup = 2
+	// Always remove the directory
+	WorkDirCleanup_WORK_DIR_CLEANUP_ALWAYS WorkDirCleanup = 3
+)
+
+// Enum value maps for WorkDirCleanup.
+var (
+	WorkDirCleanup_name = map[int32]string{
+		0: "WORK_DIR_CLEANUP_UNSPECIFIED",
+		1: "WORK_DIR_CLEANUP_ON_SUCCESS",
+		2: "WORK_DIR_CLEANUP_ON_FAILURE",
+		3: "WORK_DIR_CLEANUP_ALWAYS",
+	}
+	WorkDirCleanup_value = map[string]int32{
+		"WORK_DIR_CLEANUP_UNSPECIFIED": 0,
+		"WORK_DIR_CLEANUP_ON_SUCCESS":  1,
+		"WORK_DIR_CLEANUP_ON_FAILURE":  2,
+		"WORK_DIR_CLEANUP_ALWAYS":      3,
+	}
+)
+
+func (x WorkDirCleanup) Enum() *WorkDirCleanup {
+	p := new(WorkDirCleanup)
+	*p = x
+	return p
+}
+
+func (x WorkDirCleanup) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkDirCleanup) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_v1_executor_proto_enumTypes[0].Descriptor()
+}
+
+func (WorkDirCleanup) Type() protoreflect.EnumType {
+	return &file_executor_v1_executor_proto_enumTypes[0]
+}
+
+func (x WorkDirCleanup) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkDirCleanup.Descriptor instead.
+func (WorkDirCleanup) EnumDescriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{0}
+}
+
+// OutputMode selects how a command's output is read and delivered
+type OutputMode int32
+
+const (
+	// Output is read line by line and streamed as stdout_line/stderr_line
+	// events (the default)
+	OutputMode_OUTPUT_MODE_LINE_SCANNED OutputMode = 0
+	// Output is streamed as stdout_chunk/stderr_chunk events holding the bytes
+	// as they were read, for binary output or output without newlines.
+	// Line-based features (progress_pattern, detach_after_lines, TailProcess,
+	// log_forward) see no output in this mode. Stop_on_pattern, output
+	// filters and executor.max_output_lines_per_sec cannot be combined with
+	// it. Secrets are masked across chunks, so a chunk's end may be delivered
+	// with the next one
+	OutputMode_OUTPUT_MODE_RAW_CHUNKS OutputMode = 1
+	// Stderr is merged into stdout, in the order written, and read line by
+	// line. Stderr and stderr_bytes are then always empty
+	OutputMode_OUTPUT_MODE_COMBINED OutputMode = 2
+)
+
+// Enum value maps for OutputMode.
+var (
+	OutputMode_name = map[int32]string{
+		0: "OUTPUT_MODE_LINE_SCANNED",
+		1: "OUTPUT_MODE_RAW_CHUNKS",
+		2: "OUTPUT_MODE_COMBINED",
+	}
+	OutputMode_value = map[string]int32{
+		"OUTPUT_MODE_LINE_SCANNED": 0,
+		"OUTPUT_MODE_RAW_CHUNKS":   1,
+		"OUTPUT_MODE_COMBINED":     2,
+	}
+)
+
+func (x OutputMode) Enum() *OutputMode {
+	p := new(OutputMode)
+	*p = x
+	return p
+}
+
+func (x OutputMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutputMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_v1_executor_proto_enumTypes[1].Descriptor()
+}
+
+func (OutputMode) Type() protoreflect.EnumType {
+	return &file_executor_v1_executor_proto_enumTypes[1]
+}
+
+func (x OutputMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutputMode.Descriptor instead.
+func (OutputMode) EnumDescriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{1}
+}
+
+// ErrorCode classifies execution failures
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_CODE_UNSPECIFIED ErrorCode = 0
+	// The command wrote to stderr while fail_on_stderr was set
+	ErrorCode_ERROR_CODE_STDERR_OUTPUT ErrorCode = 1
+	// The step's stdout did not meet expect_stdout_contains or
+	// expect_stdout_regex
+	ErrorCode_ERROR_CODE_EXPECTATION_FAILED ErrorCode = 2
+	// The process closed its output but did not exit within wait_timeout and
+	// was killed
+	ErrorCode_ERROR_CODE_WAIT_TIMEOUT ErrorCode = 3
+	// A step's before or after command failed
+	ErrorCode_ERROR_CODE_STEP_COMMAND_FAILED ErrorCode = 4
+	// The command succeeded but did not create its success_file
+	ErrorCode_ERROR_CODE_SUCCESS_MARKER_MISSING ErrorCode = 5
+	// A file in the step's verify_files is missing or has another checksum
+	ErrorCode_ERROR_CODE_CHECKSUM_MISMATCH ErrorCode = 6
+	// The process ran longer than its maximum lifetime and was stopped
+	ErrorCode_ERROR_CODE_MAX_LIFETIME ErrorCode = 7
+	// The process wrote no output for idle_timeout_seconds and was stopped
+	ErrorCode_ERROR_CODE_IDLE_TIMEOUT ErrorCode = 8
+	// A file the step produces or consumes does not exist
+	ErrorCode_ERROR_CODE_ARTIFACT_MISSING ErrorCode = 9
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0: "ERROR_CODE_UNSPECIFIED",
+		1: "ERROR_CODE_STDERR_OUTPUT",
+		2: "ERROR_CODE_EXPECTATION_FAILED",
+		3: "ERROR_CODE_WAIT_TIMEOUT",
+		4: "ERROR_CODE_STEP_COMMAND_FAILED",
+		5: "ERROR_CODE_SUCCESS_MARKER_MISSING",
+		6: "ERROR_CODE_CHECKSUM_MISMATCH",
+		7: "ERROR_CODE_MAX_LIFETIME",
+		8: "ERROR_CODE_IDLE_TIMEOUT",
+		9: "ERROR_CODE_ARTIFACT_MISSING",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNSPECIFIED":            0,
+		"ERROR_CODE_STDERR_OUTPUT":          1,
+		"ERROR_CODE_EXPECTATION_FAILED":     2,
+		"ERROR_CODE_WAIT_TIMEOUT":           3,
+		"ERROR_CODE_STEP_COMMAND_FAILED":    4,
+		"ERROR_CODE_SUCCESS_MARKER_MISSING": 5,
+		"ERROR_CODE_CHECKSUM_MISMATCH":      6,
+		"ERROR_CODE_MAX_LIFETIME":           7,
+		"ERROR_CODE_IDLE_TIMEOUT":           8,
+		"ERROR_CODE_ARTIFACT_MISSING":       9,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_v1_executor_proto_enumTypes[2].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_executor_v1_executor_proto_enumTypes[2]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{2}
+}
+
+// StopReason tells why a pipeline stopped
+type StopReason int32
+
+const (
+	StopReason_STOP_REASON_UNSPECIFIED StopReason = 0
+	// Every step ran
+	StopReason_STOP_REASON_COMPLETED StopReason = 1
+	// A step failed without continue_on_error
+	StopReason_STOP_REASON_STEP_FAILED StopReason = 2
+	// The pipeline timeout expired, see PipelineTimeoutEvent
+	StopReason_STOP_REASON_TIMEOUT StopReason = 3
+	// The pipeline was cancelled
+	StopReason_STOP_REASON_CANCELLED StopReason = 4
+	// A step was rejected before it ran, e.g. a disallowed tool
+	StopReason_STOP_REASON_VALIDATION StopReason = 5
+	// A step without continue_on_error exceeded its own timeout, while the
+	// pipeline still had time left
+	StopReason_STOP_REASON_STEP_TIMEOUT StopReason = 6
+)
+
+// Enum value maps for StopReason.
+var (
+	StopReason_name = map[int32]string{
+		0: "STOP_REASON_UNSPECIFIED",
+		1: "STOP_REASON_COMPLETED",
+		2: "STOP_REASON_STEP_FAILED",
+		3: "STOP_REASON_TIMEOUT",
+		4: "STOP_REASON_CANCELLED",
+		5: "STOP_REASON_VALIDATION",
+		6: "STOP_REASON_STEP_TIMEOUT",
+	}
+	StopReason_value = map[string]int32{
+		"STOP_REASON_UNSPECIFIED":  0,
+		"STOP_REASON_COMPLETED":    1,
+		"STOP_REASON_STEP_FAILED":  2,
+		"STOP_REASON_TIMEOUT":      3,
+		"STOP_REASON_CANCELLED":    4,
+		"STOP_REASON_VALIDATION":   5,
+		"STOP_REASON_STEP_TIMEOUT": 6,
+	}
+)
+
+func (x StopReason) Enum() *StopReason {
+	p := new(StopReason)
+	*p = x
+	return p
+}
+
+func (x StopReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StopReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_v1_executor_proto_enumTypes[3].Descriptor()
+}
+
+func (StopReason) Type() protoreflect.EnumType {
+	return &file_executor_v1_executor_proto_enumTypes[3]
+}
+
+func (x StopReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StopReason.Descriptor instead.
+func (StopReason) EnumDescriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{3}
+}
+
+// LimitKind identifies a limit enforced on an execution
+type LimitKind int32
+
+const (
+	LimitKind_LIMIT_KIND_UNSPECIFIED LimitKind = 0
+	// Captured output reached max_output_bytes or the pipeline output cap
+	LimitKind_LIMIT_KIND_OUTPUT_BYTES LimitKind = 1
+	// A single line exceeded max_line_bytes and was truncated
+	LimitKind_LIMIT_KIND_LINE_BYTES      LimitKind = 2
+	LimitKind_LIMIT_KIND_MEMORY          LimitKind = 3
+	LimitKind_LIMIT_KIND_CPU             LimitKind = 4
+	LimitKind_LIMIT_KIND_WORKSPACE_QUOTA LimitKind = 5
+	// Output exceeded max_output_lines_per_sec and lines were dropped or
+	// coalesced
+	LimitKind_LIMIT_KIND_OUTPUT_RATE LimitKind = 6
+)
+
+// Enum value maps for LimitKind.
+var (
+	LimitKind_name = map[int32]string{
+		0: "LIMIT_KIND_UNSPECIFIED",
+		1: "LIMIT_KIND_OUTPUT_BYTES",
+		2: "LIMIT_KIND_LINE_BYTES",
+		3: "LIMIT_KIND_MEMORY",
+		4: "LIMIT_KIND_CPU",
+		5: "LIMIT_KIND_WORKSPACE_QUOTA",
+		6: "LIMIT_KIND_OUTPUT_RATE",
+	}
+	LimitKind_value = map[string]int32{
+		"LIMIT_KIND_UNSPECIFIED":     0,
+		"LIMIT_KIND_OUTPUT_BYTES":    1,
+		"LIMIT_KIND_LINE_BYTES":      2,
+		"LIMIT_KIND_MEMORY":          3,
+		"LIMIT_KIND_CPU":             4,
+		"LIMIT_KIND_WORKSPACE_QUOTA": 5,
+		"LIMIT_KIND_OUTPUT_RATE":     6,
+	}
+)
+
+func (x LimitKind) Enum() *LimitKind {
+	p := new(LimitKind)
+	*p = x
+	return p
+}
+
+func (x LimitKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LimitKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_v1_executor_proto_enumTypes[4].Descriptor()
+}
+
+func (LimitKind) Type() protoreflect.EnumType {
+	return &file_executor_v1_executor_proto_enumTypes[4]
+}
+
+func (x LimitKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LimitKind.Descriptor instead.
+func (LimitKind) EnumDescriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{4}
+}
+
+// TestReportFormat is the format of a step's test report file
+type TestReportFormat int32
+
+const (
+	TestReportFormat_TEST_REPORT_FORMAT_UNSPECIFIED TestReportFormat = 0
+	TestReportFormat_TEST_REPORT_FORMAT_JUNIT       TestReportFormat = 1 // JUnit XML, <testsuites> or <testsuite>
+	TestReportFormat_TEST_REPORT_FORMAT_GO_JSON     TestReportFormat = 2 // go test -json output
+)
+
+// Enum value maps for TestReportFormat.
+var (
+	TestReportFormat_name = map[int32]string{
+		0: "TEST_REPORT_FORMAT_UNSPECIFIED",
+		1: "TEST_REPORT_FORMAT_JUNIT",
+		2: "TEST_REPORT_FORMAT_GO_JSON",
+	}
+	TestReportFormat_value = map[string]int32{
+		"TEST_REPORT_FORMAT_UNSPECIFIED": 0,
+		"TEST_REPORT_FORMAT_JUNIT":       1,
+		"TEST_REPORT_FORMAT_GO_JSON":     2,
+	}
+)
+
+func (x TestReportFormat) Enum() *TestReportFormat {
+	p := new(TestReportFormat)
+	*p = x
+	return p
+}
+
+func (x TestReportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestReportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_v1_executor_proto_enumTypes[5].Descriptor()
+}
+
+func (TestReportFormat) Type() protoreflect.EnumType {
+	return &file_executor_v1_executor_proto_enumTypes[5]
+}
+
+func (x TestReportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestReportFormat.Descriptor instead.
+func (TestReportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{5}
+}
+
+// ImagePullState is the state of an image pre-pull
+type ImagePullState int32
+
+const (
+	ImagePullState_IMAGE_PULL_STATE_UNSPECIFIED ImagePullState = 0
+	ImagePullState_IMAGE_PULL_STATE_STARTED     ImagePullState = 1
+	ImagePullState_IMAGE_PULL_STATE_SUCCEEDED   ImagePullState = 2
+	ImagePullState_IMAGE_PULL_STATE_FAILED      ImagePullState = 3
+)
+
+// Enum value maps for ImagePullState.
+var (
+	ImagePullState_name = map[int32]string{
+		0: "IMAGE_PULL_STATE_UNSPECIFIED",
+		1: "IMAGE_PULL_STATE_STARTED",
+		2: "IMAGE_PULL_STATE_SUCCEEDED",
+		3: "IMAGE_PULL_STATE_FAILED",
+	}
+	ImagePullState_value = map[string]int32{
+		"IMAGE_PULL_STATE_UNSPECIFIED": 0,
+		"IMAGE_PULL_STATE_STARTED":     1,
+		"IMAGE_PULL_STATE_SUCCEEDED":   2,
+		"IMAGE_PULL_STATE_FAILED":      3,
+	}
+)
+
+func (x ImagePullState) Enum() *ImagePullState {
+	p := new(ImagePullState)
+	*p = x
+	return p
+}
+
+func (x ImagePullState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImagePullState) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_v1_executor_proto_enumTypes[6].Descriptor()
+}
+
+func (ImagePullState) Type() protoreflect.EnumType {
+	return &file_executor_v1_executor_proto_enumTypes[6]
+}
+
+func (x ImagePullState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImagePullState.Descriptor instead.
+func (ImagePullState) EnumDescriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{6}
+}
+
+// CancelState is the state of a process a cancellation was requested for
+type CancelState int32
+
+const (
+	CancelState_CANCEL_STATE_UNSPECIFIED CancelState = 0
+	// The process was signalled and is still running, within its grace
+	// period or beyond wait_ms
+	CancelState_CANCEL_STATE_SIGNALED CancelState = 1
+	// The process was signalled and exited within wait_ms
+	CancelState_CANCEL_STATE_TERMINATED CancelState = 2
+	// No process with the ID is running
+	CancelState_CANCEL_STATE_NOT_FOUND CancelState = 3
+	// The process had already exited, it was not signalled
+	CancelState_CANCEL_STATE_ALREADY_EXITED CancelState = 4
+)
+
+// Enum value maps for CancelState.
+var (
+	CancelState_name = map[int32]string{
+		0: "CANCEL_STATE_UNSPECIFIED",
+		1: "CANCEL_STATE_SIGNALED",
+		2: "CANCEL_STATE_TERMINATED",
+		3: "CANCEL_STATE_NOT_FOUND",
+		4: "CANCEL_STATE_ALREADY_EXITED",
+	}
+	CancelState_value = map[string]int32{
+		"CANCEL_STATE_UNSPECIFIED":    0,
+		"CANCEL_STATE_SIGNALED":       1,
+		"CANCEL_STATE_TERMINATED":     2,
+		"CANCEL_STATE_NOT_FOUND":      3,
+		"CANCEL_STATE_ALREADY_EXITED": 4,
+	}
+)
+
+func (x CancelState) Enum() *CancelState {
+	p := new(CancelState)
+	*p = x
+	return p
+}
+
+func (x CancelState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancelState) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_v1_executor_proto_enumTypes[7].Descriptor()
+}
+
+func (CancelState) Type() protoreflect.EnumType {
+	return &file_executor_v1_executor_proto_enumTypes[7]
+}
+
+func (x CancelState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancelState.Descriptor instead.
+func (CancelState) EnumDescriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{7}
+}
+
+// ResultState is the state of a detached execution
+type ResultState int32
+
+const (
+	ResultState_RESULT_STATE_UNSPECIFIED ResultState = 0
+	ResultState_RESULT_STATE_RUNNING     ResultState = 1
+	ResultState_RESULT_STATE_COMPLETED   ResultState = 2
+)
+
+// Enum value maps for ResultState.
+var (
+	ResultState_name = map[int32]string{
+		0: "RESULT_STATE_UNSPECIFIED",
+		1: "RESULT_STATE_RUNNING",
+		2: "RESULT_STATE_COMPLETED",
+	}
+	ResultState_value = map[string]int32{
+		"RESULT_STATE_UNSPECIFIED": 0,
+		"RESULT_STATE_RUNNING":     1,
+		"RESULT_STATE_COMPLETED":   2,
+	}
+)
+
+func (x ResultState) Enum() *ResultState {
+	p := new(ResultState)
+	*p = x
+	return p
+}
+
+func (x ResultState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResultState) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_v1_executor_proto_enumTypes[8].Descriptor()
+}
+
+func (ResultState) Type() protoreflect.EnumType {
+	return &file_executor_v1_executor_proto_enumTypes[8]
+}
+
+func (x ResultState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResultState.Descriptor instead.
+func (ResultState) EnumDescriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{8}
+}
+
 // ExecuteRequest represents a command execution request
 type ExecuteRequest struct {
 	state         protoimpl.MessageState
@@ -30,14 +579,106 @@ type ExecuteRequest struct {
 
 	// Tool is the command to execute (git, npm, mvn, etc.)
 	Tool string `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
-	// Args are the command arguments
+	// Args are the command arguments. ${NAME} template variables are expanded
 	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
 	// WorkDir is the working directory for the command
 	WorkDir string `protobuf:"bytes,3,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
-	// Env are additional environment variables (KEY=VALUE format)
+	// Env are additional environment variables (KEY=VALUE format), overriding
+	// inherited variables with the same key. ${NAME} template variables are
+	// expanded
 	Env []string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty"`
 	// TimeoutSeconds is the maximum execution time (0 = use default)
 	TimeoutSeconds int32 `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// CreateWorkDir creates WorkDir if it does not exist yet
+	CreateWorkDir bool `protobuf:"varint,6,opt,name=create_work_dir,json=createWorkDir,proto3" json:"create_work_dir,omitempty"`
+	// CleanupWorkDir removes WorkDir after the process exits, but only when
+	// the server created it (see CreateWorkDir)
+	CleanupWorkDir WorkDirCleanup `protobuf:"varint,7,opt,name=cleanup_work_dir,json=cleanupWorkDir,proto3,enum=executor.v1.WorkDirCleanup" json:"cleanup_work_dir,omitempty"`
+	// ProgressPattern is a regex with a named "percent" capture. Streamed
+	// output lines that match are sent as ProgressEvents instead of output
+	ProgressPattern string `protobuf:"bytes,8,opt,name=progress_pattern,json=progressPattern,proto3" json:"progress_pattern,omitempty"`
+	// LogArgs controls whether args appear in server logs (default true).
+	// When false only the argument count and a hash are logged
+	LogArgs *bool `protobuf:"varint,9,opt,name=log_args,json=logArgs,proto3,oneof" json:"log_args,omitempty"`
+	// FastPath captures output without line scanning, which is cheaper for
+	// short commands. It only applies to unary Execute; overlong lines are
+	// kept as they are and progress_pattern is not used
+	FastPath bool `protobuf:"varint,10,opt,name=fast_path,json=fastPath,proto3" json:"fast_path,omitempty"`
+	// ShellCommand runs this script through the shell instead of tool, which
+	// must then be empty. Args follow the script, e.g. as $0, $1 for sh -c
+	ShellCommand string `protobuf:"bytes,11,opt,name=shell_command,json=shellCommand,proto3" json:"shell_command,omitempty"`
+	// ShellPath overrides executor.shell for shell_command: the interpreter
+	// and its flags, e.g. "bash -c" or "pwsh -Command" ("-c" if only the
+	// interpreter is given). The interpreter must be an allowed tool
+	ShellPath string `protobuf:"bytes,12,opt,name=shell_path,json=shellPath,proto3" json:"shell_path,omitempty"`
+	// Priority is the nice value the process runs at, from -20 (highest) to
+	// 19 (lowest). Raising priority needs privileges. Ignored where unsupported
+	Priority *int32 `protobuf:"varint,13,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	// IoPriority is the best-effort I/O priority level, from 0 (highest) to
+	// 7 (lowest), like ionice -c 2. Linux only, ignored elsewhere
+	IoPriority *int32 `protobuf:"varint,14,opt,name=io_priority,json=ioPriority,proto3,oneof" json:"io_priority,omitempty"`
+	// WorkspaceName runs the command in an acquired workspace (see
+	// AcquireWorkspace). WorkDir, if set, must then be relative to it
+	WorkspaceName string `protobuf:"bytes,15,opt,name=workspace_name,json=workspaceName,proto3" json:"workspace_name,omitempty"`
+	// TailLines keeps only the last N lines of stdout and stderr in memory and
+	// in the response (0 = keep all). Output still streams live, and with
+	// spill_to_disk the full output is written to the spill files
+	TailLines int32 `protobuf:"varint,16,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	// DetachAfterLines makes ExecuteStream send a StartedEvent, stream this
+	// many output lines, then send a DetachedEvent and end the call while the
+	// process keeps running. Fetch the result with GetResult. Once set, the
+	// process also keeps running if the client goes away early. ExecuteStream
+	// only
+	DetachAfterLines int32 `protobuf:"varint,17,opt,name=detach_after_lines,json=detachAfterLines,proto3" json:"detach_after_lines,omitempty"`
+	// ExpectedExitCodes are the exit codes that count as success (default 0
+	// only), e.g. [0, 1] for diff. exit_code still reports the actual code
+	ExpectedExitCodes []int32 `protobuf:"varint,18,rep,packed,name=expected_exit_codes,json=expectedExitCodes,proto3" json:"expected_exit_codes,omitempty"`
+	// SuccessFile is a path relative to work_dir that the command must have
+	// created for a successful run to count as success, for tools that exit 0
+	// even when they failed
+	SuccessFile string `protobuf:"bytes,19,opt,name=success_file,json=successFile,proto3" json:"success_file,omitempty"`
+	// CaptureStdout and CaptureStderr keep the stream in the response
+	// (default true). When false the stream is discarded and its response
+	// field left empty, but it is still streamed live and counted in
+	// stdout_bytes/stderr_bytes
+	CaptureStdout *bool `protobuf:"varint,20,opt,name=capture_stdout,json=captureStdout,proto3,oneof" json:"capture_stdout,omitempty"`
+	CaptureStderr *bool `protobuf:"varint,21,opt,name=capture_stderr,json=captureStderr,proto3,oneof" json:"capture_stderr,omitempty"`
+	// IsolateHome runs the command with HOME and the XDG base directories
+	// pointing at a new empty directory under executor.isolated_home_dir,
+	// removed when the command exits, so tool state such as credentials does
+	// not carry over between executions
+	IsolateHome bool `protobuf:"varint,22,opt,name=isolate_home,json=isolateHome,proto3" json:"isolate_home,omitempty"`
+	// EmitEnvSnapshot sends an EnvSnapshotEvent with the environment the
+	// process was started with to ExecuteStream and ExecutePipelineStream
+	// clients, unless executor.env_snapshot is disabled
+	EmitEnvSnapshot bool `protobuf:"varint,23,opt,name=emit_env_snapshot,json=emitEnvSnapshot,proto3" json:"emit_env_snapshot,omitempty"`
+	// OutputMode selects how output is read and delivered, see OutputMode
+	OutputMode OutputMode `protobuf:"varint,24,opt,name=output_mode,json=outputMode,proto3,enum=executor.v1.OutputMode" json:"output_mode,omitempty"`
+	// HashOutput and HashStderr report the SHA-256 of the kept stdout and
+	// stderr in the response, hashed as the output is read
+	HashOutput bool `protobuf:"varint,25,opt,name=hash_output,json=hashOutput,proto3" json:"hash_output,omitempty"`
+	HashStderr bool `protobuf:"varint,26,opt,name=hash_stderr,json=hashStderr,proto3" json:"hash_stderr,omitempty"`
+	// StopOnPattern is a regex; once an output line matches, the process is
+	// sent the cancel signal and the execution succeeds with
+	// stopped_on_pattern set, whatever its exit status. Rejected with
+	// OUTPUT_MODE_RAW_CHUNKS
+	StopOnPattern string `protobuf:"bytes,27,opt,name=stop_on_pattern,json=stopOnPattern,proto3" json:"stop_on_pattern,omitempty"`
+	// MaxLifetimeSeconds hard-caps how long the process may run, on top of
+	// timeout_seconds and any other limit. The shorter of it and
+	// executor.max_lifetime applies. Exceeding it fails the execution with
+	// ERROR_CODE_MAX_LIFETIME
+	MaxLifetimeSeconds int32 `protobuf:"varint,28,opt,name=max_lifetime_seconds,json=maxLifetimeSeconds,proto3" json:"max_lifetime_seconds,omitempty"`
+	// IdleTimeoutSeconds stops the process once it has written no output for
+	// this long, failing with ERROR_CODE_IDLE_TIMEOUT (0 = no limit). Output
+	// on either stream counts. Disables fast_path
+	IdleTimeoutSeconds int32 `protobuf:"varint,29,opt,name=idle_timeout_seconds,json=idleTimeoutSeconds,proto3" json:"idle_timeout_seconds,omitempty"`
+	// OutputFilters replaces executor.output_filters for this request, e.g.
+	// ["none"] to receive output unfiltered. Empty = the configured filters
+	OutputFilters []string `protobuf:"bytes,30,rep,name=output_filters,json=outputFilters,proto3" json:"output_filters,omitempty"`
+	// JobKey and FenceToken fence a logical job against duplicate runs, see
+	// PipelineRequest
+	JobKey     string `protobuf:"bytes,31,opt,name=job_key,json=jobKey,proto3" json:"job_key,omitempty"`
+	FenceToken uint64 `protobuf:"varint,32,opt,name=fence_token,json=fenceToken,proto3" json:"fence_token,omitempty"`
 }
 
 func (x *ExecuteRequest) Reset() {
@@ -107,292 +748,281 @@ func (x *ExecuteRequest) GetTimeoutSeconds() int32 {
 	return 0
 }
 
-// ExecuteResponse contains the result of a command execution
-type ExecuteResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// ProcessId is the unique identifier for this execution
-	ProcessId string `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
-	// Tool is the command that was executed
-	Tool string `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`
-	// Args are the command arguments
-	Args []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
-	// Success indicates if the command completed successfully
-	Success bool `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	// ExitCode is the process exit code
-	ExitCode int32 `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
-	// Stdout is the standard output
-	Stdout string `protobuf:"bytes,6,opt,name=stdout,proto3" json:"stdout,omitempty"`
-	// Stderr is the standard error output
-	Stderr string `protobuf:"bytes,7,opt,name=stderr,proto3" json:"stderr,omitempty"`
-	// Error message if command failed
-	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
-	// TimedOut indicates if the command was killed due to timeout
-	TimedOut bool `protobuf:"varint,9,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
-	// DurationMs is how long the command ran in milliseconds
-	DurationMs int64 `protobuf:"varint,10,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
-	// StartedAt is when the command started
-	StartedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	// EndedAt is when the command completed
-	EndedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+func (x *ExecuteRequest) GetCreateWorkDir() bool {
+	if x != nil {
+		return x.CreateWorkDir
+	}
+	return false
 }
 
-func (x *ExecuteResponse) Reset() {
-	*x = ExecuteResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecuteRequest) GetCleanupWorkDir() WorkDirCleanup {
+	if x != nil {
+		return x.CleanupWorkDir
 	}
+	return WorkDirCleanup_WORK_DIR_CLEANUP_UNSPECIFIED
 }
 
-func (x *ExecuteResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecuteRequest) GetProgressPattern() string {
+	if x != nil {
+		return x.ProgressPattern
+	}
+	return ""
 }
 
-func (*ExecuteResponse) ProtoMessage() {}
-
-func (x *ExecuteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecuteRequest) GetLogArgs() bool {
+	if x != nil && x.LogArgs != nil {
+		return *x.LogArgs
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ExecuteResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteResponse) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{1}
+func (x *ExecuteRequest) GetFastPath() bool {
+	if x != nil {
+		return x.FastPath
+	}
+	return false
 }
 
-func (x *ExecuteResponse) GetProcessId() string {
+func (x *ExecuteRequest) GetShellCommand() string {
 	if x != nil {
-		return x.ProcessId
+		return x.ShellCommand
 	}
 	return ""
 }
 
-func (x *ExecuteResponse) GetTool() string {
+func (x *ExecuteRequest) GetShellPath() string {
 	if x != nil {
-		return x.Tool
+		return x.ShellPath
 	}
 	return ""
 }
 
-func (x *ExecuteResponse) GetArgs() []string {
-	if x != nil {
-		return x.Args
+func (x *ExecuteRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
 	}
-	return nil
+	return 0
 }
 
-func (x *ExecuteResponse) GetSuccess() bool {
+func (x *ExecuteRequest) GetIoPriority() int32 {
+	if x != nil && x.IoPriority != nil {
+		return *x.IoPriority
+	}
+	return 0
+}
+
+func (x *ExecuteRequest) GetWorkspaceName() string {
 	if x != nil {
-		return x.Success
+		return x.WorkspaceName
 	}
-	return false
+	return ""
 }
 
-func (x *ExecuteResponse) GetExitCode() int32 {
+func (x *ExecuteRequest) GetTailLines() int32 {
 	if x != nil {
-		return x.ExitCode
+		return x.TailLines
 	}
 	return 0
 }
 
-func (x *ExecuteResponse) GetStdout() string {
+func (x *ExecuteRequest) GetDetachAfterLines() int32 {
 	if x != nil {
-		return x.Stdout
+		return x.DetachAfterLines
 	}
-	return ""
+	return 0
 }
 
-func (x *ExecuteResponse) GetStderr() string {
+func (x *ExecuteRequest) GetExpectedExitCodes() []int32 {
 	if x != nil {
-		return x.Stderr
+		return x.ExpectedExitCodes
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecuteResponse) GetError() string {
+func (x *ExecuteRequest) GetSuccessFile() string {
 	if x != nil {
-		return x.Error
+		return x.SuccessFile
 	}
 	return ""
 }
 
-func (x *ExecuteResponse) GetTimedOut() bool {
-	if x != nil {
-		return x.TimedOut
+func (x *ExecuteRequest) GetCaptureStdout() bool {
+	if x != nil && x.CaptureStdout != nil {
+		return *x.CaptureStdout
 	}
 	return false
 }
 
-func (x *ExecuteResponse) GetDurationMs() int64 {
-	if x != nil {
-		return x.DurationMs
+func (x *ExecuteRequest) GetCaptureStderr() bool {
+	if x != nil && x.CaptureStderr != nil {
+		return *x.CaptureStderr
 	}
-	return 0
+	return false
 }
 
-func (x *ExecuteResponse) GetStartedAt() *timestamppb.Timestamp {
+func (x *ExecuteRequest) GetIsolateHome() bool {
 	if x != nil {
-		return x.StartedAt
+		return x.IsolateHome
 	}
-	return nil
+	return false
 }
 
-func (x *ExecuteResponse) GetEndedAt() *timestamppb.Timestamp {
+func (x *ExecuteRequest) GetEmitEnvSnapshot() bool {
 	if x != nil {
-		return x.EndedAt
+		return x.EmitEnvSnapshot
 	}
-	return nil
-}
-
-// ExecuteStreamResponse streams command output in real-time
-type ExecuteStreamResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Output:
-	//
-	//	*ExecuteStreamResponse_StdoutLine
-	//	*ExecuteStreamResponse_StderrLine
-	//	*ExecuteStreamResponse_Result
-	Output isExecuteStreamResponse_Output `protobuf_oneof:"output"`
+	return false
 }
 
-func (x *ExecuteStreamResponse) Reset() {
-	*x = ExecuteStreamResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecuteRequest) GetOutputMode() OutputMode {
+	if x != nil {
+		return x.OutputMode
 	}
+	return OutputMode_OUTPUT_MODE_LINE_SCANNED
 }
 
-func (x *ExecuteStreamResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ExecuteStreamResponse) ProtoMessage() {}
-
-func (x *ExecuteStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecuteRequest) GetHashOutput() bool {
+	if x != nil {
+		return x.HashOutput
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ExecuteStreamResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteStreamResponse) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{2}
+	return false
 }
 
-func (m *ExecuteStreamResponse) GetOutput() isExecuteStreamResponse_Output {
-	if m != nil {
-		return m.Output
+func (x *ExecuteRequest) GetHashStderr() bool {
+	if x != nil {
+		return x.HashStderr
 	}
-	return nil
+	return false
 }
 
-func (x *ExecuteStreamResponse) GetStdoutLine() string {
-	if x, ok := x.GetOutput().(*ExecuteStreamResponse_StdoutLine); ok {
-		return x.StdoutLine
+func (x *ExecuteRequest) GetStopOnPattern() string {
+	if x != nil {
+		return x.StopOnPattern
 	}
 	return ""
 }
 
-func (x *ExecuteStreamResponse) GetStderrLine() string {
-	if x, ok := x.GetOutput().(*ExecuteStreamResponse_StderrLine); ok {
-		return x.StderrLine
+func (x *ExecuteRequest) GetMaxLifetimeSeconds() int32 {
+	if x != nil {
+		return x.MaxLifetimeSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *ExecuteStreamResponse) GetResult() *ExecuteResponse {
-	if x, ok := x.GetOutput().(*ExecuteStreamResponse_Result); ok {
-		return x.Result
+func (x *ExecuteRequest) GetIdleTimeoutSeconds() int32 {
+	if x != nil {
+		return x.IdleTimeoutSeconds
 	}
-	return nil
-}
-
-type isExecuteStreamResponse_Output interface {
-	isExecuteStreamResponse_Output()
+	return 0
 }
 
-type ExecuteStreamResponse_StdoutLine struct {
-	// StdoutLine is a line from stdout
-	StdoutLine string `protobuf:"bytes,1,opt,name=stdout_line,json=stdoutLine,proto3,oneof"`
+func (x *ExecuteRequest) GetOutputFilters() []string {
+	if x != nil {
+		return x.OutputFilters
+	}
+	return nil
 }
 
-type ExecuteStreamResponse_StderrLine struct {
-	// StderrLine is a line from stderr
-	StderrLine string `protobuf:"bytes,2,opt,name=stderr_line,json=stderrLine,proto3,oneof"`
+func (x *ExecuteRequest) GetJobKey() string {
+	if x != nil {
+		return x.JobKey
+	}
+	return ""
 }
 
-type ExecuteStreamResponse_Result struct {
-	// Result is sent when execution completes
-	Result *ExecuteResponse `protobuf:"bytes,3,opt,name=result,proto3,oneof"`
+func (x *ExecuteRequest) GetFenceToken() uint64 {
+	if x != nil {
+		return x.FenceToken
+	}
+	return 0
 }
 
-func (*ExecuteStreamResponse_StdoutLine) isExecuteStreamResponse_Output() {}
-
-func (*ExecuteStreamResponse_StderrLine) isExecuteStreamResponse_Output() {}
-
-func (*ExecuteStreamResponse_Result) isExecuteStreamResponse_Output() {}
-
-// BuildStep represents a step in a build pipeline
-type BuildStep struct {
+// ExecuteResponse contains the result of a command execution
+type ExecuteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name is the display name of the step
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Tool is the command to execute
+	// ProcessId is the unique identifier for this execution
+	ProcessId string `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	// Tool is the command that was executed
 	Tool string `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`
 	// Args are the command arguments
 	Args []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
-	// WorkDir is the working directory (relative to workspace)
-	WorkDir string `protobuf:"bytes,4,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
-	// Env are step-specific environment variables
-	Env []string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty"`
-	// ContinueOnError allows the pipeline to continue if this step fails
-	ContinueOnError bool `protobuf:"varint,6,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
-	// TimeoutSeconds is step-specific timeout
-	TimeoutSeconds int32 `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// Success indicates if the command completed successfully
+	Success bool `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// ExitCode is the process exit code
+	ExitCode int32 `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// Stdout is the standard output
+	Stdout string `protobuf:"bytes,6,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	// Stderr is the standard error output
+	Stderr string `protobuf:"bytes,7,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// Error message if command failed
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// TimedOut indicates if the command was killed due to timeout
+	TimedOut bool `protobuf:"varint,9,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	// DurationMs is how long the command ran in milliseconds
+	DurationMs int64 `protobuf:"varint,10,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// StartedAt is when the command started
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// EndedAt is when the command completed
+	EndedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	// OsPid is the operating system process ID (0 if the process never started)
+	OsPid int32 `protobuf:"varint,13,opt,name=os_pid,json=osPid,proto3" json:"os_pid,omitempty"`
+	// LimitsHit lists the limits enforced while the command ran
+	LimitsHit []*LimitEvent `protobuf:"bytes,14,rep,name=limits_hit,json=limitsHit,proto3" json:"limits_hit,omitempty"`
+	// ErrorCode classifies why the execution failed, when it is not simply
+	// a non-zero exit code
+	ErrorCode ErrorCode `protobuf:"varint,15,opt,name=error_code,json=errorCode,proto3,enum=executor.v1.ErrorCode" json:"error_code,omitempty"`
+	// StdoutBytes and StderrBytes count all output the command wrote,
+	// including anything not kept in stdout/stderr because of limits
+	StdoutBytes int64 `protobuf:"varint,16,opt,name=stdout_bytes,json=stdoutBytes,proto3" json:"stdout_bytes,omitempty"`
+	StderrBytes int64 `protobuf:"varint,17,opt,name=stderr_bytes,json=stderrBytes,proto3" json:"stderr_bytes,omitempty"`
+	// StdoutFile and StderrFile hold the rest of the output when it spilled
+	// to disk (see executor.spill_to_disk); stdout/stderr then hold the start
+	StdoutFile string `protobuf:"bytes,18,opt,name=stdout_file,json=stdoutFile,proto3" json:"stdout_file,omitempty"`
+	StderrFile string `protobuf:"bytes,19,opt,name=stderr_file,json=stderrFile,proto3" json:"stderr_file,omitempty"`
+	// QueueWaitMs is time spent waiting for a concurrency slot and admission,
+	// StartupMs the time from then until the process started and RunMs the
+	// time the process ran
+	QueueWaitMs int64 `protobuf:"varint,20,opt,name=queue_wait_ms,json=queueWaitMs,proto3" json:"queue_wait_ms,omitempty"`
+	StartupMs   int64 `protobuf:"varint,21,opt,name=startup_ms,json=startupMs,proto3" json:"startup_ms,omitempty"`
+	RunMs       int64 `protobuf:"varint,22,opt,name=run_ms,json=runMs,proto3" json:"run_ms,omitempty"`
+	// StartAttempts is how many times the process was started, more than one
+	// when starting it failed for lack of resources (see
+	// executor.start_retries). Unrelated to step retries
+	StartAttempts int32 `protobuf:"varint,23,opt,name=start_attempts,json=startAttempts,proto3" json:"start_attempts,omitempty"`
+	// OutputSha256 and StderrSha256 are the hex SHA-256 of the output kept
+	// for the response (stdout plus stdout_file when spilled, or the returned
+	// tail with tail_lines), set with hash_output and hash_stderr. The
+	// partial flags are set when that is not all of the output, e.g. because
+	// of max_output_bytes
+	OutputSha256      string `protobuf:"bytes,24,opt,name=output_sha256,json=outputSha256,proto3" json:"output_sha256,omitempty"`
+	OutputHashPartial bool   `protobuf:"varint,25,opt,name=output_hash_partial,json=outputHashPartial,proto3" json:"output_hash_partial,omitempty"`
+	StderrSha256      string `protobuf:"bytes,26,opt,name=stderr_sha256,json=stderrSha256,proto3" json:"stderr_sha256,omitempty"`
+	StderrHashPartial bool   `protobuf:"varint,27,opt,name=stderr_hash_partial,json=stderrHashPartial,proto3" json:"stderr_hash_partial,omitempty"`
+	// StoppedOnPattern is set when the process was stopped because its
+	// output matched stop_on_pattern
+	StoppedOnPattern bool `protobuf:"varint,28,opt,name=stopped_on_pattern,json=stoppedOnPattern,proto3" json:"stopped_on_pattern,omitempty"`
 }
 
-func (x *BuildStep) Reset() {
-	*x = BuildStep{}
+func (x *ExecuteResponse) Reset() {
+	*x = ExecuteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[3]
+		mi := &file_executor_v1_executor_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BuildStep) String() string {
+func (x *ExecuteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildStep) ProtoMessage() {}
+func (*ExecuteResponse) ProtoMessage() {}
 
-func (x *BuildStep) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[3]
+func (x *ExecuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,284 +1033,243 @@ func (x *BuildStep) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildStep.ProtoReflect.Descriptor instead.
-func (*BuildStep) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ExecuteResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *BuildStep) GetName() string {
+func (x *ExecuteResponse) GetProcessId() string {
 	if x != nil {
-		return x.Name
+		return x.ProcessId
 	}
 	return ""
 }
 
-func (x *BuildStep) GetTool() string {
+func (x *ExecuteResponse) GetTool() string {
 	if x != nil {
 		return x.Tool
 	}
 	return ""
 }
 
-func (x *BuildStep) GetArgs() []string {
+func (x *ExecuteResponse) GetArgs() []string {
 	if x != nil {
 		return x.Args
 	}
 	return nil
 }
 
-func (x *BuildStep) GetWorkDir() string {
+func (x *ExecuteResponse) GetSuccess() bool {
 	if x != nil {
-		return x.WorkDir
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExecuteResponse) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ExecuteResponse) GetStdout() string {
+	if x != nil {
+		return x.Stdout
 	}
 	return ""
 }
 
-func (x *BuildStep) GetEnv() []string {
+func (x *ExecuteResponse) GetStderr() string {
 	if x != nil {
-		return x.Env
+		return x.Stderr
 	}
-	return nil
+	return ""
 }
 
-func (x *BuildStep) GetContinueOnError() bool {
+func (x *ExecuteResponse) GetError() string {
 	if x != nil {
-		return x.ContinueOnError
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExecuteResponse) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
 	}
 	return false
 }
 
-func (x *BuildStep) GetTimeoutSeconds() int32 {
+func (x *ExecuteResponse) GetDurationMs() int64 {
 	if x != nil {
-		return x.TimeoutSeconds
+		return x.DurationMs
 	}
 	return 0
 }
 
-// PipelineRequest represents a pipeline execution request
-type PipelineRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Id is the unique pipeline execution ID
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Name is the pipeline name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// WorkspaceDir is the base workspace directory
-	WorkspaceDir string `protobuf:"bytes,3,opt,name=workspace_dir,json=workspaceDir,proto3" json:"workspace_dir,omitempty"`
-	// Steps are the pipeline steps to execute
-	Steps []*BuildStep `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
-	// Env are pipeline-level environment variables
-	Env []string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty"`
-	// TimeoutSeconds is the overall pipeline timeout
-	TimeoutSeconds int32 `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+func (x *ExecuteResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
 }
 
-func (x *PipelineRequest) Reset() {
-	*x = PipelineRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecuteResponse) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
 	}
+	return nil
 }
 
-func (x *PipelineRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecuteResponse) GetOsPid() int32 {
+	if x != nil {
+		return x.OsPid
+	}
+	return 0
 }
 
-func (*PipelineRequest) ProtoMessage() {}
-
-func (x *PipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecuteResponse) GetLimitsHit() []*LimitEvent {
+	if x != nil {
+		return x.LimitsHit
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PipelineRequest.ProtoReflect.Descriptor instead.
-func (*PipelineRequest) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{4}
+func (x *ExecuteResponse) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
 }
 
-func (x *PipelineRequest) GetId() string {
+func (x *ExecuteResponse) GetStdoutBytes() int64 {
 	if x != nil {
-		return x.Id
+		return x.StdoutBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *PipelineRequest) GetName() string {
+func (x *ExecuteResponse) GetStderrBytes() int64 {
 	if x != nil {
-		return x.Name
+		return x.StderrBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *PipelineRequest) GetWorkspaceDir() string {
+func (x *ExecuteResponse) GetStdoutFile() string {
 	if x != nil {
-		return x.WorkspaceDir
+		return x.StdoutFile
 	}
 	return ""
 }
 
-func (x *PipelineRequest) GetSteps() []*BuildStep {
+func (x *ExecuteResponse) GetStderrFile() string {
 	if x != nil {
-		return x.Steps
+		return x.StderrFile
 	}
-	return nil
+	return ""
 }
 
-func (x *PipelineRequest) GetEnv() []string {
+func (x *ExecuteResponse) GetQueueWaitMs() int64 {
 	if x != nil {
-		return x.Env
+		return x.QueueWaitMs
 	}
-	return nil
+	return 0
 }
 
-func (x *PipelineRequest) GetTimeoutSeconds() int32 {
+func (x *ExecuteResponse) GetStartupMs() int64 {
 	if x != nil {
-		return x.TimeoutSeconds
+		return x.StartupMs
 	}
 	return 0
 }
 
-// StepResult contains the result of a single step
-type StepResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Name is the step name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// StepIndex is the step position (0-based)
-	StepIndex int32 `protobuf:"varint,2,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
-	// ExecuteResult contains the execution details
-	ExecuteResult *ExecuteResponse `protobuf:"bytes,3,opt,name=execute_result,json=executeResult,proto3" json:"execute_result,omitempty"`
-	// Skipped indicates if the step was skipped
-	Skipped bool `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
-	// SkipReason is why the step was skipped
-	SkipReason string `protobuf:"bytes,5,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
-}
-
-func (x *StepResult) Reset() {
-	*x = StepResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecuteResponse) GetRunMs() int64 {
+	if x != nil {
+		return x.RunMs
 	}
+	return 0
 }
 
-func (x *StepResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StepResult) ProtoMessage() {}
-
-func (x *StepResult) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecuteResponse) GetStartAttempts() int32 {
+	if x != nil {
+		return x.StartAttempts
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StepResult.ProtoReflect.Descriptor instead.
-func (*StepResult) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{5}
+	return 0
 }
 
-func (x *StepResult) GetName() string {
+func (x *ExecuteResponse) GetOutputSha256() string {
 	if x != nil {
-		return x.Name
+		return x.OutputSha256
 	}
 	return ""
 }
 
-func (x *StepResult) GetStepIndex() int32 {
+func (x *ExecuteResponse) GetOutputHashPartial() bool {
 	if x != nil {
-		return x.StepIndex
+		return x.OutputHashPartial
 	}
-	return 0
+	return false
 }
 
-func (x *StepResult) GetExecuteResult() *ExecuteResponse {
+func (x *ExecuteResponse) GetStderrSha256() string {
 	if x != nil {
-		return x.ExecuteResult
+		return x.StderrSha256
 	}
-	return nil
+	return ""
 }
 
-func (x *StepResult) GetSkipped() bool {
+func (x *ExecuteResponse) GetStderrHashPartial() bool {
 	if x != nil {
-		return x.Skipped
+		return x.StderrHashPartial
 	}
 	return false
 }
 
-func (x *StepResult) GetSkipReason() string {
+func (x *ExecuteResponse) GetStoppedOnPattern() bool {
 	if x != nil {
-		return x.SkipReason
+		return x.StoppedOnPattern
 	}
-	return ""
+	return false
 }
 
-// PipelineResponse contains the result of a pipeline execution
-type PipelineResponse struct {
+// LimitEvent reports that a limit was enforced
+type LimitEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// PipelineId is the pipeline execution ID
-	PipelineId string `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
-	// Name is the pipeline name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Success indicates if all steps completed successfully
-	Success bool `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	// StepResults contains results for each step
-	StepResults []*StepResult `protobuf:"bytes,4,rep,name=step_results,json=stepResults,proto3" json:"step_results,omitempty"`
-	// TotalDurationMs is the overall pipeline duration in milliseconds
-	TotalDurationMs int64 `protobuf:"varint,5,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
-	// StartedAt is when the pipeline started
-	StartedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	// EndedAt is when the pipeline completed
-	EndedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
-	// FailedStep is the name of the first failed step (if any)
-	FailedStep string `protobuf:"bytes,8,opt,name=failed_step,json=failedStep,proto3" json:"failed_step,omitempty"`
-	// TotalSteps is the total number of steps
-	TotalSteps int32 `protobuf:"varint,9,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
-	// CompletedSteps is the number of completed steps
-	CompletedSteps int32 `protobuf:"varint,10,opt,name=completed_steps,json=completedSteps,proto3" json:"completed_steps,omitempty"`
-}
-
-func (x *PipelineResponse) Reset() {
-	*x = PipelineResponse{}
+	// Limit is the kind of limit that was hit
+	Limit LimitKind `protobuf:"varint,1,opt,name=limit,proto3,enum=executor.v1.LimitKind" json:"limit,omitempty"`
+	// Value is the configured limit that was enforced
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Detail describes what was affected, e.g. "stdout"
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	// OccurredAt is when the limit was enforced
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// StepName and StepIndex identify the pipeline step, if any
+	StepName  string `protobuf:"bytes,5,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
+	StepIndex int32  `protobuf:"varint,6,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+}
+
+func (x *LimitEvent) Reset() {
+	*x = LimitEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[6]
+		mi := &file_executor_v1_executor_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineResponse) String() string {
+func (x *LimitEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineResponse) ProtoMessage() {}
+func (*LimitEvent) ProtoMessage() {}
 
-func (x *PipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[6]
+func (x *LimitEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,113 +1280,135 @@ func (x *PipelineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineResponse.ProtoReflect.Descriptor instead.
-func (*PipelineResponse) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use LimitEvent.ProtoReflect.Descriptor instead.
+func (*LimitEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PipelineResponse) GetPipelineId() string {
+func (x *LimitEvent) GetLimit() LimitKind {
 	if x != nil {
-		return x.PipelineId
+		return x.Limit
 	}
-	return ""
+	return LimitKind_LIMIT_KIND_UNSPECIFIED
 }
 
-func (x *PipelineResponse) GetName() string {
+func (x *LimitEvent) GetValue() int64 {
 	if x != nil {
-		return x.Name
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-func (x *PipelineResponse) GetSuccess() bool {
+func (x *LimitEvent) GetDetail() string {
 	if x != nil {
-		return x.Success
+		return x.Detail
 	}
-	return false
+	return ""
 }
 
-func (x *PipelineResponse) GetStepResults() []*StepResult {
+func (x *LimitEvent) GetOccurredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StepResults
+		return x.OccurredAt
 	}
 	return nil
 }
 
-func (x *PipelineResponse) GetTotalDurationMs() int64 {
+func (x *LimitEvent) GetStepName() string {
 	if x != nil {
-		return x.TotalDurationMs
+		return x.StepName
 	}
-	return 0
+	return ""
 }
 
-func (x *PipelineResponse) GetStartedAt() *timestamppb.Timestamp {
+func (x *LimitEvent) GetStepIndex() int32 {
 	if x != nil {
-		return x.StartedAt
+		return x.StepIndex
 	}
-	return nil
+	return 0
 }
 
-func (x *PipelineResponse) GetEndedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndedAt
-	}
-	return nil
+// ExecuteBatchRequest represents a batch of independent command executions
+type ExecuteBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Requests are run concurrently, up to max_concurrent at a time. At most
+	// executor.max_batch_size requests are accepted
+	Requests []*ExecuteRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *PipelineResponse) GetFailedStep() string {
-	if x != nil {
-		return x.FailedStep
+func (x *ExecuteBatchRequest) Reset() {
+	*x = ExecuteBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *PipelineResponse) GetTotalSteps() int32 {
-	if x != nil {
-		return x.TotalSteps
+func (x *ExecuteBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteBatchRequest) ProtoMessage() {}
+
+func (x *ExecuteBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PipelineResponse) GetCompletedSteps() int32 {
+// Deprecated: Use ExecuteBatchRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteBatchRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExecuteBatchRequest) GetRequests() []*ExecuteRequest {
 	if x != nil {
-		return x.CompletedSteps
+		return x.Requests
 	}
-	return 0
+	return nil
 }
 
-// PipelineStreamResponse streams pipeline execution progress
-type PipelineStreamResponse struct {
+// BatchResult contains the outcome of a single command in a batch
+type BatchResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Event:
-	//
-	//	*PipelineStreamResponse_StepStarted
-	//	*PipelineStreamResponse_StepOutput
-	//	*PipelineStreamResponse_StepCompleted
-	//	*PipelineStreamResponse_PipelineCompleted
-	Event isPipelineStreamResponse_Event `protobuf_oneof:"event"`
+	// Index is the position of the request in the batch
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Result is set when the command was executed
+	Result *ExecuteResponse `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// Error is set when the command could not be executed at all, including
+	// when the batch call ended before it started
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *PipelineStreamResponse) Reset() {
-	*x = PipelineStreamResponse{}
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[7]
+		mi := &file_executor_v1_executor_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineStreamResponse) String() string {
+func (x *BatchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineStreamResponse) ProtoMessage() {}
+func (*BatchResult) ProtoMessage() {}
 
-func (x *PipelineStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[7]
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,107 +1419,65 @@ func (x *PipelineStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineStreamResponse.ProtoReflect.Descriptor instead.
-func (*PipelineStreamResponse) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{4}
 }
 
-func (m *PipelineStreamResponse) GetEvent() isPipelineStreamResponse_Event {
-	if m != nil {
-		return m.Event
+func (x *BatchResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-func (x *PipelineStreamResponse) GetStepStarted() *StepStartedEvent {
-	if x, ok := x.GetEvent().(*PipelineStreamResponse_StepStarted); ok {
-		return x.StepStarted
-	}
-	return nil
-}
-
-func (x *PipelineStreamResponse) GetStepOutput() *StepOutputEvent {
-	if x, ok := x.GetEvent().(*PipelineStreamResponse_StepOutput); ok {
-		return x.StepOutput
-	}
-	return nil
-}
-
-func (x *PipelineStreamResponse) GetStepCompleted() *StepResult {
-	if x, ok := x.GetEvent().(*PipelineStreamResponse_StepCompleted); ok {
-		return x.StepCompleted
+func (x *BatchResult) GetResult() *ExecuteResponse {
+	if x != nil {
+		return x.Result
 	}
 	return nil
 }
 
-func (x *PipelineStreamResponse) GetPipelineCompleted() *PipelineResponse {
-	if x, ok := x.GetEvent().(*PipelineStreamResponse_PipelineCompleted); ok {
-		return x.PipelineCompleted
+func (x *BatchResult) GetError() string {
+	if x != nil {
+		return x.Error
 	}
-	return nil
-}
-
-type isPipelineStreamResponse_Event interface {
-	isPipelineStreamResponse_Event()
-}
-
-type PipelineStreamResponse_StepStarted struct {
-	// StepStarted is sent when a step begins
-	StepStarted *StepStartedEvent `protobuf:"bytes,1,opt,name=step_started,json=stepStarted,proto3,oneof"`
-}
-
-type PipelineStreamResponse_StepOutput struct {
-	// StepOutput is sent for step output lines
-	StepOutput *StepOutputEvent `protobuf:"bytes,2,opt,name=step_output,json=stepOutput,proto3,oneof"`
-}
-
-type PipelineStreamResponse_StepCompleted struct {
-	// StepCompleted is sent when a step finishes
-	StepCompleted *StepResult `protobuf:"bytes,3,opt,name=step_completed,json=stepCompleted,proto3,oneof"`
-}
-
-type PipelineStreamResponse_PipelineCompleted struct {
-	// PipelineCompleted is sent when the entire pipeline finishes
-	PipelineCompleted *PipelineResponse `protobuf:"bytes,4,opt,name=pipeline_completed,json=pipelineCompleted,proto3,oneof"`
+	return ""
 }
 
-func (*PipelineStreamResponse_StepStarted) isPipelineStreamResponse_Event() {}
-
-func (*PipelineStreamResponse_StepOutput) isPipelineStreamResponse_Event() {}
-
-func (*PipelineStreamResponse_StepCompleted) isPipelineStreamResponse_Event() {}
-
-func (*PipelineStreamResponse_PipelineCompleted) isPipelineStreamResponse_Event() {}
-
-// StepStartedEvent is sent when a step begins execution
-type StepStartedEvent struct {
+// ExecuteBatchResponse contains the results of a batch, in request order
+type ExecuteBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StepName   string                 `protobuf:"bytes,1,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
-	StepIndex  int32                  `protobuf:"varint,2,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
-	TotalSteps int32                  `protobuf:"varint,3,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
-	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Results has one entry per request, in the same order
+	Results []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Succeeded is the number of commands that completed successfully
+	Succeeded int32 `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	// Failed is the number of commands that failed or could not be executed
+	Failed int32 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// DurationMs is how long the whole batch took in milliseconds
+	DurationMs int64 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
 }
 
-func (x *StepStartedEvent) Reset() {
-	*x = StepStartedEvent{}
+func (x *ExecuteBatchResponse) Reset() {
+	*x = ExecuteBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[8]
+		mi := &file_executor_v1_executor_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StepStartedEvent) String() string {
+func (x *ExecuteBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StepStartedEvent) ProtoMessage() {}
+func (*ExecuteBatchResponse) ProtoMessage() {}
 
-func (x *StepStartedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[8]
+func (x *ExecuteBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,71 +1488,80 @@ func (x *StepStartedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StepStartedEvent.ProtoReflect.Descriptor instead.
-func (*StepStartedEvent) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ExecuteBatchResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteBatchResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *StepStartedEvent) GetStepName() string {
+func (x *ExecuteBatchResponse) GetResults() []*BatchResult {
 	if x != nil {
-		return x.StepName
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *StepStartedEvent) GetStepIndex() int32 {
+func (x *ExecuteBatchResponse) GetSucceeded() int32 {
 	if x != nil {
-		return x.StepIndex
+		return x.Succeeded
 	}
 	return 0
 }
 
-func (x *StepStartedEvent) GetTotalSteps() int32 {
+func (x *ExecuteBatchResponse) GetFailed() int32 {
 	if x != nil {
-		return x.TotalSteps
+		return x.Failed
 	}
 	return 0
 }
 
-func (x *StepStartedEvent) GetStartedAt() *timestamppb.Timestamp {
+func (x *ExecuteBatchResponse) GetDurationMs() int64 {
 	if x != nil {
-		return x.StartedAt
+		return x.DurationMs
 	}
-	return nil
+	return 0
 }
 
-// StepOutputEvent streams step output in real-time
-type StepOutputEvent struct {
+// ExecuteStreamResponse streams command output in real-time
+type ExecuteStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StepName  string `protobuf:"bytes,1,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
-	StepIndex int32  `protobuf:"varint,2,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
 	// Types that are assignable to Output:
 	//
-	//	*StepOutputEvent_StdoutLine
-	//	*StepOutputEvent_StderrLine
-	Output isStepOutputEvent_Output `protobuf_oneof:"output"`
+	//	*ExecuteStreamResponse_StdoutLine
+	//	*ExecuteStreamResponse_StderrLine
+	//	*ExecuteStreamResponse_Result
+	//	*ExecuteStreamResponse_Limit
+	//	*ExecuteStreamResponse_Deadline
+	//	*ExecuteStreamResponse_Progress
+	//	*ExecuteStreamResponse_Summary
+	//	*ExecuteStreamResponse_Started
+	//	*ExecuteStreamResponse_Detached
+	//	*ExecuteStreamResponse_EnvSnapshot
+	//	*ExecuteStreamResponse_StdoutChunk
+	//	*ExecuteStreamResponse_StderrChunk
+	//	*ExecuteStreamResponse_RateLimited
+	Output isExecuteStreamResponse_Output `protobuf_oneof:"output"`
 }
 
-func (x *StepOutputEvent) Reset() {
-	*x = StepOutputEvent{}
+func (x *ExecuteStreamResponse) Reset() {
+	*x = ExecuteStreamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[9]
+		mi := &file_executor_v1_executor_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StepOutputEvent) String() string {
+func (x *ExecuteStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StepOutputEvent) ProtoMessage() {}
+func (*ExecuteStreamResponse) ProtoMessage() {}
 
-func (x *StepOutputEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[9]
+func (x *ExecuteStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,192 +1572,237 @@ func (x *StepOutputEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StepOutputEvent.ProtoReflect.Descriptor instead.
-func (*StepOutputEvent) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *StepOutputEvent) GetStepName() string {
-	if x != nil {
-		return x.StepName
-	}
-	return ""
-}
-
-func (x *StepOutputEvent) GetStepIndex() int32 {
-	if x != nil {
-		return x.StepIndex
-	}
-	return 0
+// Deprecated: Use ExecuteStreamResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteStreamResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{6}
 }
 
-func (m *StepOutputEvent) GetOutput() isStepOutputEvent_Output {
+func (m *ExecuteStreamResponse) GetOutput() isExecuteStreamResponse_Output {
 	if m != nil {
 		return m.Output
 	}
 	return nil
 }
 
-func (x *StepOutputEvent) GetStdoutLine() string {
-	if x, ok := x.GetOutput().(*StepOutputEvent_StdoutLine); ok {
+func (x *ExecuteStreamResponse) GetStdoutLine() string {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_StdoutLine); ok {
 		return x.StdoutLine
 	}
 	return ""
 }
 
-func (x *StepOutputEvent) GetStderrLine() string {
-	if x, ok := x.GetOutput().(*StepOutputEvent_StderrLine); ok {
+func (x *ExecuteStreamResponse) GetStderrLine() string {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_StderrLine); ok {
 		return x.StderrLine
 	}
 	return ""
 }
 
-type isStepOutputEvent_Output interface {
-	isStepOutputEvent_Output()
+func (x *ExecuteStreamResponse) GetResult() *ExecuteResponse {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_Result); ok {
+		return x.Result
+	}
+	return nil
 }
 
-type StepOutputEvent_StdoutLine struct {
-	StdoutLine string `protobuf:"bytes,3,opt,name=stdout_line,json=stdoutLine,proto3,oneof"`
+func (x *ExecuteStreamResponse) GetLimit() *LimitEvent {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_Limit); ok {
+		return x.Limit
+	}
+	return nil
 }
 
-type StepOutputEvent_StderrLine struct {
-	StderrLine string `protobuf:"bytes,4,opt,name=stderr_line,json=stderrLine,proto3,oneof"`
+func (x *ExecuteStreamResponse) GetDeadline() *DeadlineEvent {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_Deadline); ok {
+		return x.Deadline
+	}
+	return nil
 }
 
-func (*StepOutputEvent_StdoutLine) isStepOutputEvent_Output() {}
-
-func (*StepOutputEvent_StderrLine) isStepOutputEvent_Output() {}
-
-// CancelRequest requests cancellation of a running process
-type CancelRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ExecuteStreamResponse) GetProgress() *ProgressEvent {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
 
-	ProcessId string `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+func (x *ExecuteStreamResponse) GetSummary() *SummaryEvent {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_Summary); ok {
+		return x.Summary
+	}
+	return nil
 }
 
-func (x *CancelRequest) Reset() {
-	*x = CancelRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecuteStreamResponse) GetStarted() *StartedEvent {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_Started); ok {
+		return x.Started
 	}
+	return nil
 }
 
-func (x *CancelRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecuteStreamResponse) GetDetached() *DetachedEvent {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_Detached); ok {
+		return x.Detached
+	}
+	return nil
 }
 
-func (*CancelRequest) ProtoMessage() {}
+func (x *ExecuteStreamResponse) GetEnvSnapshot() *EnvSnapshotEvent {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_EnvSnapshot); ok {
+		return x.EnvSnapshot
+	}
+	return nil
+}
 
-func (x *CancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecuteStreamResponse) GetStdoutChunk() []byte {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_StdoutChunk); ok {
+		return x.StdoutChunk
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
-func (*CancelRequest) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{10}
+func (x *ExecuteStreamResponse) GetStderrChunk() []byte {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_StderrChunk); ok {
+		return x.StderrChunk
+	}
+	return nil
 }
 
-func (x *CancelRequest) GetProcessId() string {
-	if x != nil {
-		return x.ProcessId
+func (x *ExecuteStreamResponse) GetRateLimited() *RateLimitedEvent {
+	if x, ok := x.GetOutput().(*ExecuteStreamResponse_RateLimited); ok {
+		return x.RateLimited
 	}
-	return ""
+	return nil
 }
 
-// CancelResponse confirms process cancellation
-type CancelResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type isExecuteStreamResponse_Output interface {
+	isExecuteStreamResponse_Output()
+}
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+type ExecuteStreamResponse_StdoutLine struct {
+	// StdoutLine is a line from stdout
+	StdoutLine string `protobuf:"bytes,1,opt,name=stdout_line,json=stdoutLine,proto3,oneof"`
 }
 
-func (x *CancelResponse) Reset() {
-	*x = CancelResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type ExecuteStreamResponse_StderrLine struct {
+	// StderrLine is a line from stderr
+	StderrLine string `protobuf:"bytes,2,opt,name=stderr_line,json=stderrLine,proto3,oneof"`
 }
 
-func (x *CancelResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type ExecuteStreamResponse_Result struct {
+	// Result is sent when execution completes
+	Result *ExecuteResponse `protobuf:"bytes,3,opt,name=result,proto3,oneof"`
 }
 
-func (*CancelResponse) ProtoMessage() {}
+type ExecuteStreamResponse_Limit struct {
+	// Limit is sent when a limit is enforced
+	Limit *LimitEvent `protobuf:"bytes,4,opt,name=limit,proto3,oneof"`
+}
 
-func (x *CancelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type ExecuteStreamResponse_Deadline struct {
+	// Deadline is sent periodically while the command runs
+	Deadline *DeadlineEvent `protobuf:"bytes,5,opt,name=deadline,proto3,oneof"`
 }
 
-// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
-func (*CancelResponse) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{11}
+type ExecuteStreamResponse_Progress struct {
+	// Progress is sent for output lines matching progress_pattern
+	Progress *ProgressEvent `protobuf:"bytes,6,opt,name=progress,proto3,oneof"`
 }
 
-func (x *CancelResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+type ExecuteStreamResponse_Summary struct {
+	// Summary is sent just before the result
+	Summary *SummaryEvent `protobuf:"bytes,7,opt,name=summary,proto3,oneof"`
 }
 
-func (x *CancelResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+type ExecuteStreamResponse_Started struct {
+	// Started is sent once the process is running, with detach_after_lines
+	Started *StartedEvent `protobuf:"bytes,8,opt,name=started,proto3,oneof"`
 }
 
-// CancelPipelineRequest requests cancellation of a running pipeline
-type CancelPipelineRequest struct {
+type ExecuteStreamResponse_Detached struct {
+	// Detached is the last message when the stream detaches from the process
+	Detached *DetachedEvent `protobuf:"bytes,9,opt,name=detached,proto3,oneof"`
+}
+
+type ExecuteStreamResponse_EnvSnapshot struct {
+	// EnvSnapshot is sent once the process is running, for requests with
+	// emit_env_snapshot
+	EnvSnapshot *EnvSnapshotEvent `protobuf:"bytes,10,opt,name=env_snapshot,json=envSnapshot,proto3,oneof"`
+}
+
+type ExecuteStreamResponse_StdoutChunk struct {
+	// StdoutChunk and StderrChunk carry output read in
+	// OUTPUT_MODE_RAW_CHUNKS
+	StdoutChunk []byte `protobuf:"bytes,11,opt,name=stdout_chunk,json=stdoutChunk,proto3,oneof"`
+}
+
+type ExecuteStreamResponse_StderrChunk struct {
+	StderrChunk []byte `protobuf:"bytes,12,opt,name=stderr_chunk,json=stderrChunk,proto3,oneof"`
+}
+
+type ExecuteStreamResponse_RateLimited struct {
+	// RateLimited is sent after a second in which output exceeded
+	// executor.max_output_lines_per_sec
+	RateLimited *RateLimitedEvent `protobuf:"bytes,13,opt,name=rate_limited,json=rateLimited,proto3,oneof"`
+}
+
+func (*ExecuteStreamResponse_StdoutLine) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_StderrLine) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_Result) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_Limit) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_Deadline) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_Progress) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_Summary) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_Started) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_Detached) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_EnvSnapshot) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_StdoutChunk) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_StderrChunk) isExecuteStreamResponse_Output() {}
+
+func (*ExecuteStreamResponse_RateLimited) isExecuteStreamResponse_Output() {}
+
+// EnvSnapshotEvent lists the environment a process was started with
+type EnvSnapshotEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PipelineId string `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	ProcessId string `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	// Vars are the process's environment variables, in the order passed to it
+	Vars []*EnvVar `protobuf:"bytes,2,rep,name=vars,proto3" json:"vars,omitempty"`
+	// StepName and StepIndex identify the pipeline step, if any
+	StepName  string `protobuf:"bytes,3,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
+	StepIndex int32  `protobuf:"varint,4,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
 }
 
-func (x *CancelPipelineRequest) Reset() {
-	*x = CancelPipelineRequest{}
+func (x *EnvSnapshotEvent) Reset() {
+	*x = EnvSnapshotEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[12]
+		mi := &file_executor_v1_executor_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelPipelineRequest) String() string {
+func (x *EnvSnapshotEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelPipelineRequest) ProtoMessage() {}
+func (*EnvSnapshotEvent) ProtoMessage() {}
 
-func (x *CancelPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[12]
+func (x *EnvSnapshotEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,47 +1813,69 @@ func (x *CancelPipelineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelPipelineRequest.ProtoReflect.Descriptor instead.
-func (*CancelPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use EnvSnapshotEvent.ProtoReflect.Descriptor instead.
+func (*EnvSnapshotEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CancelPipelineRequest) GetPipelineId() string {
+func (x *EnvSnapshotEvent) GetProcessId() string {
 	if x != nil {
-		return x.PipelineId
+		return x.ProcessId
 	}
 	return ""
 }
 
-// CancelPipelineResponse confirms pipeline cancellation
-type CancelPipelineResponse struct {
+func (x *EnvSnapshotEvent) GetVars() []*EnvVar {
+	if x != nil {
+		return x.Vars
+	}
+	return nil
+}
+
+func (x *EnvSnapshotEvent) GetStepName() string {
+	if x != nil {
+		return x.StepName
+	}
+	return ""
+}
+
+func (x *EnvSnapshotEvent) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+// EnvVar is one environment variable of an EnvSnapshotEvent
+type EnvVar struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Number of processes that were cancelled
-	CancelledProcesses int32 `protobuf:"varint,3,opt,name=cancelled_processes,json=cancelledProcesses,proto3" json:"cancelled_processes,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Value is empty when redacted
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Redacted is set when the key matches executor.env_snapshot.redact_keys
+	Redacted bool `protobuf:"varint,3,opt,name=redacted,proto3" json:"redacted,omitempty"`
 }
 
-func (x *CancelPipelineResponse) Reset() {
-	*x = CancelPipelineResponse{}
+func (x *EnvVar) Reset() {
+	*x = EnvVar{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[13]
+		mi := &file_executor_v1_executor_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelPipelineResponse) String() string {
+func (x *EnvVar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelPipelineResponse) ProtoMessage() {}
+func (*EnvVar) ProtoMessage() {}
 
-func (x *CancelPipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[13]
+func (x *EnvVar) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,56 +1886,59 @@ func (x *CancelPipelineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelPipelineResponse.ProtoReflect.Descriptor instead.
-func (*CancelPipelineResponse) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use EnvVar.ProtoReflect.Descriptor instead.
+func (*EnvVar) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CancelPipelineResponse) GetSuccess() bool {
+func (x *EnvVar) GetKey() string {
 	if x != nil {
-		return x.Success
+		return x.Key
 	}
-	return false
+	return ""
 }
 
-func (x *CancelPipelineResponse) GetMessage() string {
+func (x *EnvVar) GetValue() string {
 	if x != nil {
-		return x.Message
+		return x.Value
 	}
 	return ""
 }
 
-func (x *CancelPipelineResponse) GetCancelledProcesses() int32 {
+func (x *EnvVar) GetRedacted() bool {
 	if x != nil {
-		return x.CancelledProcesses
+		return x.Redacted
 	}
-	return 0
+	return false
 }
 
-// GetProcessesRequest requests list of running processes
-type GetProcessesRequest struct {
+// StartedEvent identifies a started process
+type StartedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ProcessId string                 `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
 }
 
-func (x *GetProcessesRequest) Reset() {
-	*x = GetProcessesRequest{}
+func (x *StartedEvent) Reset() {
+	*x = StartedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[14]
+		mi := &file_executor_v1_executor_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProcessesRequest) String() string {
+func (x *StartedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProcessesRequest) ProtoMessage() {}
+func (*StartedEvent) ProtoMessage() {}
 
-func (x *GetProcessesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[14]
+func (x *StartedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,41 +1949,54 @@ func (x *GetProcessesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProcessesRequest.ProtoReflect.Descriptor instead.
-func (*GetProcessesRequest) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use StartedEvent.ProtoReflect.Descriptor instead.
+func (*StartedEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{9}
 }
 
-// ProcessInfo contains information about a running process
-type ProcessInfo struct {
+func (x *StartedEvent) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *StartedEvent) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+// DetachedEvent ends a stream whose process keeps running
+type DetachedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProcessId  string                 `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
-	Tool       string                 `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`
-	Args       []string               `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
-	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	DurationMs int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// ProcessId identifies the result for GetResult
+	ProcessId string `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	// LinesSent counts the output lines streamed before detaching
+	LinesSent int32 `protobuf:"varint,2,opt,name=lines_sent,json=linesSent,proto3" json:"lines_sent,omitempty"`
 }
 
-func (x *ProcessInfo) Reset() {
-	*x = ProcessInfo{}
+func (x *DetachedEvent) Reset() {
+	*x = DetachedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[15]
+		mi := &file_executor_v1_executor_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProcessInfo) String() string {
+func (x *DetachedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessInfo) ProtoMessage() {}
+func (*DetachedEvent) ProtoMessage() {}
 
-func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[15]
+func (x *DetachedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,73 +2007,60 @@ func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
-func (*ProcessInfo) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DetachedEvent.ProtoReflect.Descriptor instead.
+func (*DetachedEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ProcessInfo) GetProcessId() string {
+func (x *DetachedEvent) GetProcessId() string {
 	if x != nil {
 		return x.ProcessId
 	}
 	return ""
 }
 
-func (x *ProcessInfo) GetTool() string {
-	if x != nil {
-		return x.Tool
-	}
-	return ""
-}
-
-func (x *ProcessInfo) GetArgs() []string {
-	if x != nil {
-		return x.Args
-	}
-	return nil
-}
-
-func (x *ProcessInfo) GetStartedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartedAt
-	}
-	return nil
-}
-
-func (x *ProcessInfo) GetDurationMs() int64 {
+func (x *DetachedEvent) GetLinesSent() int32 {
 	if x != nil {
-		return x.DurationMs
+		return x.LinesSent
 	}
 	return 0
 }
 
-// GetProcessesResponse contains running process information
-type GetProcessesResponse struct {
+// SummaryEvent totals a streamed execution's output, so clients can show
+// it without counting every line
+type SummaryEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Processes []*ProcessInfo `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
-	Count     int32          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// StdoutLines and StderrLines count the lines streamed
+	StdoutLines int64 `protobuf:"varint,1,opt,name=stdout_lines,json=stdoutLines,proto3" json:"stdout_lines,omitempty"`
+	StderrLines int64 `protobuf:"varint,2,opt,name=stderr_lines,json=stderrLines,proto3" json:"stderr_lines,omitempty"`
+	// StdoutBytes and StderrBytes count every byte written to each stream,
+	// including output beyond limits
+	StdoutBytes int64 `protobuf:"varint,3,opt,name=stdout_bytes,json=stdoutBytes,proto3" json:"stdout_bytes,omitempty"`
+	StderrBytes int64 `protobuf:"varint,4,opt,name=stderr_bytes,json=stderrBytes,proto3" json:"stderr_bytes,omitempty"`
+	ExitCode    int32 `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	DurationMs  int64 `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
 }
 
-func (x *GetProcessesResponse) Reset() {
-	*x = GetProcessesResponse{}
+func (x *SummaryEvent) Reset() {
+	*x = SummaryEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[16]
+		mi := &file_executor_v1_executor_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProcessesResponse) String() string {
+func (x *SummaryEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProcessesResponse) ProtoMessage() {}
+func (*SummaryEvent) ProtoMessage() {}
 
-func (x *GetProcessesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[16]
+func (x *SummaryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1423,496 +2071,7204 @@ func (x *GetProcessesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProcessesResponse.ProtoReflect.Descriptor instead.
-func (*GetProcessesResponse) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SummaryEvent.ProtoReflect.Descriptor instead.
+func (*SummaryEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetProcessesResponse) GetProcesses() []*ProcessInfo {
+func (x *SummaryEvent) GetStdoutLines() int64 {
 	if x != nil {
-		return x.Processes
+		return x.StdoutLines
 	}
-	return nil
+	return 0
 }
 
-func (x *GetProcessesResponse) GetCount() int32 {
+func (x *SummaryEvent) GetStderrLines() int64 {
 	if x != nil {
-		return x.Count
+		return x.StderrLines
 	}
 	return 0
 }
 
-// HealthRequest requests service health status
-type HealthRequest struct {
+func (x *SummaryEvent) GetStdoutBytes() int64 {
+	if x != nil {
+		return x.StdoutBytes
+	}
+	return 0
+}
+
+func (x *SummaryEvent) GetStderrBytes() int64 {
+	if x != nil {
+		return x.StderrBytes
+	}
+	return 0
+}
+
+func (x *SummaryEvent) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *SummaryEvent) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// BuildStep represents a step in a build pipeline
+type BuildStep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Name is the display name of the step
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Tool is the command to execute
+	Tool string `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`
+	// Args are the command arguments
+	Args []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// WorkDir is the working directory (relative to workspace)
+	WorkDir string `protobuf:"bytes,4,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
+	// Env are step-specific environment variables, overriding pipeline env
+	// and inherited variables with the same key
+	Env []string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty"`
+	// ContinueOnError allows the pipeline to continue if this step fails
+	ContinueOnError bool `protobuf:"varint,6,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
+	// TimeoutSeconds is step-specific timeout
+	TimeoutSeconds int32 `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// ProgressPattern is a regex with a named "percent" capture, see ExecuteRequest
+	ProgressPattern string `protobuf:"bytes,8,opt,name=progress_pattern,json=progressPattern,proto3" json:"progress_pattern,omitempty"`
+	// FailOnStderr fails the step if it writes anything to stderr, even when
+	// it exits 0. With continue_on_error the pipeline still continues
+	FailOnStderr bool `protobuf:"varint,9,opt,name=fail_on_stderr,json=failOnStderr,proto3" json:"fail_on_stderr,omitempty"`
+	// LogArgs controls whether args appear in server logs, see ExecuteRequest
+	LogArgs *bool `protobuf:"varint,10,opt,name=log_args,json=logArgs,proto3,oneof" json:"log_args,omitempty"`
+	// MaxRetries is how many times a failed step is run again (0 = never)
+	MaxRetries int32 `protobuf:"varint,11,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// RetryBackoffSeconds is the delay before the first retry, doubling for
+	// each further attempt (0 = retry immediately)
+	RetryBackoffSeconds int32 `protobuf:"varint,12,opt,name=retry_backoff_seconds,json=retryBackoffSeconds,proto3" json:"retry_backoff_seconds,omitempty"`
+	// RetryOnExitCodes limits retries to these exit codes (empty = any
+	// non-zero exit code). Timeouts and start failures are never retried
+	RetryOnExitCodes []int32 `protobuf:"varint,13,rep,packed,name=retry_on_exit_codes,json=retryOnExitCodes,proto3" json:"retry_on_exit_codes,omitempty"`
+	// TimeoutFraction limits the step to this fraction (0-1] of the time left
+	// on the pipeline timeout when it starts. Ignored without a pipeline
+	// timeout; timeout_seconds still applies if it is shorter
+	TimeoutFraction float64 `protobuf:"fixed64,14,opt,name=timeout_fraction,json=timeoutFraction,proto3" json:"timeout_fraction,omitempty"`
+	// ShellCommand and ShellPath run a shell script, see ExecuteRequest
+	ShellCommand string `protobuf:"bytes,15,opt,name=shell_command,json=shellCommand,proto3" json:"shell_command,omitempty"`
+	ShellPath    string `protobuf:"bytes,16,opt,name=shell_path,json=shellPath,proto3" json:"shell_path,omitempty"`
+	// Image runs the step inside this container image using the server's
+	// container_runtime, with the workspace mounted at the same path. The tool
+	// must still be allowed
+	Image string `protobuf:"bytes,17,opt,name=image,proto3" json:"image,omitempty"`
+	// Priority and IoPriority lower or raise the step's CPU and I/O
+	// priority, see ExecuteRequest
+	Priority   *int32 `protobuf:"varint,18,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	IoPriority *int32 `protobuf:"varint,19,opt,name=io_priority,json=ioPriority,proto3,oneof" json:"io_priority,omitempty"`
+	// ExpectStdoutContains and ExpectStdoutRegex fail an otherwise successful
+	// step unless its captured stdout contains the string or matches the
+	// regular expression. Output beyond max_output_bytes is not checked
+	ExpectStdoutContains string `protobuf:"bytes,20,opt,name=expect_stdout_contains,json=expectStdoutContains,proto3" json:"expect_stdout_contains,omitempty"`
+	ExpectStdoutRegex    string `protobuf:"bytes,21,opt,name=expect_stdout_regex,json=expectStdoutRegex,proto3" json:"expect_stdout_regex,omitempty"`
+	// TailLines keeps only the last N output lines, see ExecuteRequest
+	TailLines int32 `protobuf:"varint,22,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	// ExpectedExitCodes are the exit codes that count as success, see
+	// ExecuteRequest
+	ExpectedExitCodes []int32 `protobuf:"varint,23,rep,packed,name=expected_exit_codes,json=expectedExitCodes,proto3" json:"expected_exit_codes,omitempty"`
+	// Before commands run in order, in the step's work dir and env, before the
+	// step's command. If one fails the rest and the step's command are skipped
+	// and the step fails. After commands run once the step's command has
+	// finished, even when it failed, unless the pipeline was cancelled or
+	// timed out. A failing after command fails an otherwise successful step.
+	// Retries only repeat the step's command
+	Before []*StepCommand `protobuf:"bytes,24,rep,name=before,proto3" json:"before,omitempty"`
+	After  []*StepCommand `protobuf:"bytes,25,rep,name=after,proto3" json:"after,omitempty"`
+	// SuccessFile is a marker file the step must create, see ExecuteRequest
+	SuccessFile string `protobuf:"bytes,26,opt,name=success_file,json=successFile,proto3" json:"success_file,omitempty"`
+	// CaptureStdout and CaptureStderr keep the step's output in its result,
+	// see ExecuteRequest
+	CaptureStdout *bool `protobuf:"varint,27,opt,name=capture_stdout,json=captureStdout,proto3,oneof" json:"capture_stdout,omitempty"`
+	CaptureStderr *bool `protobuf:"varint,28,opt,name=capture_stderr,json=captureStderr,proto3,oneof" json:"capture_stderr,omitempty"`
+	// IsolateHome gives the step its own temporary HOME, see ExecuteRequest.
+	// Before and after commands each get their own too
+	IsolateHome bool `protobuf:"varint,29,opt,name=isolate_home,json=isolateHome,proto3" json:"isolate_home,omitempty"`
+	// EmitEnvSnapshot streams the step's environment, see ExecuteRequest
+	EmitEnvSnapshot bool `protobuf:"varint,30,opt,name=emit_env_snapshot,json=emitEnvSnapshot,proto3" json:"emit_env_snapshot,omitempty"`
+	// Use names an executor.step_library entry this step is based on. Fields
+	// set on the step override the entry's; lists replace the entry's, except
+	// env which is applied on top of it. Name defaults to the entry name
+	Use string `protobuf:"bytes,31,opt,name=use,proto3" json:"use,omitempty"`
+	// VerifyFiles maps paths relative to the step's work_dir to their expected
+	// SHA-256 hex digest. After the step succeeds each file is hashed and the
+	// step fails if one is missing or differs
+	VerifyFiles map[string]string `protobuf:"bytes,32,rep,name=verify_files,json=verifyFiles,proto3" json:"verify_files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// OutputMode selects how the step's output is read, see ExecuteRequest
+	OutputMode OutputMode `protobuf:"varint,33,opt,name=output_mode,json=outputMode,proto3,enum=executor.v1.OutputMode" json:"output_mode,omitempty"`
+	// HashOutput and HashStderr hash the step's output, see ExecuteRequest
+	HashOutput bool `protobuf:"varint,34,opt,name=hash_output,json=hashOutput,proto3" json:"hash_output,omitempty"`
+	HashStderr bool `protobuf:"varint,35,opt,name=hash_stderr,json=hashStderr,proto3" json:"hash_stderr,omitempty"`
+	// StopOnPattern stops the step once a line matches, see ExecuteRequest
+	StopOnPattern string `protobuf:"bytes,36,opt,name=stop_on_pattern,json=stopOnPattern,proto3" json:"stop_on_pattern,omitempty"`
+	// MaxLifetimeSeconds caps the step's run time, see ExecuteRequest
+	MaxLifetimeSeconds int32 `protobuf:"varint,37,opt,name=max_lifetime_seconds,json=maxLifetimeSeconds,proto3" json:"max_lifetime_seconds,omitempty"`
+	// IdleTimeoutSeconds stops the step when it goes quiet, see
+	// ExecuteRequest
+	IdleTimeoutSeconds int32 `protobuf:"varint,38,opt,name=idle_timeout_seconds,json=idleTimeoutSeconds,proto3" json:"idle_timeout_seconds,omitempty"`
+	// CaptureLastLineAs stores the last non-blank line of the step's kept
+	// stdout, trimmed, as a variable later steps reference as ${NAME} in args
+	// and env. Only set when the step succeeds; empty output gives ""
+	CaptureLastLineAs string `protobuf:"bytes,39,opt,name=capture_last_line_as,json=captureLastLineAs,proto3" json:"capture_last_line_as,omitempty"`
+	// Produces lists files, relative to the pipeline's workspace_dir, the
+	// step creates. After the step succeeds it fails with
+	// ERROR_CODE_ARTIFACT_MISSING if one of them does not exist
+	Produces []string `protobuf:"bytes,40,rep,name=produces,proto3" json:"produces,omitempty"`
+	// Consumes lists files, relative to the pipeline's workspace_dir, the
+	// step reads. A step consuming what an earlier step produces depends on
+	// it; consuming what a later step produces is rejected. The step fails
+	// with ERROR_CODE_ARTIFACT_MISSING if one of them does not exist when it
+	// starts
+	Consumes []string `protobuf:"bytes,41,rep,name=consumes,proto3" json:"consumes,omitempty"`
+	// RunIfChanged lists globs for paths relative to the repository root, "**"
+	// matching any number of directories. When the pipeline's changed_files
+	// is set and none of them match, the step is skipped. Steps without globs
+	// always run
+	RunIfChanged []string `protobuf:"bytes,42,rep,name=run_if_changed,json=runIfChanged,proto3" json:"run_if_changed,omitempty"`
+	// TestReport is a test report file the step writes, parsed after it ran
+	// into StepResult.test_report
+	TestReport *TestReportSpec `protobuf:"bytes,43,opt,name=test_report,json=testReport,proto3" json:"test_report,omitempty"`
 }
 
-func (x *HealthRequest) Reset() {
-	*x = HealthRequest{}
+func (x *BuildStep) Reset() {
+	*x = BuildStep{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[17]
+		mi := &file_executor_v1_executor_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HealthRequest) String() string {
+func (x *BuildStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthRequest) ProtoMessage() {}
+func (*BuildStep) ProtoMessage() {}
 
-func (x *HealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[17]
+func (x *BuildStep) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
-func (*HealthRequest) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{17}
-}
-
-// HealthResponse contains service health information
-type HealthResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	RunningCount  int32                  `protobuf:"varint,3,opt,name=running_count,json=runningCount,proto3" json:"running_count,omitempty"`
-	MaxConcurrent int32                  `protobuf:"varint,4,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
-	UptimeSeconds float64                `protobuf:"fixed64,5,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
-	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
-}
-
-func (x *HealthResponse) Reset() {
-	*x = HealthResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_v1_executor_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *HealthResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*HealthResponse) ProtoMessage() {}
-
-func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_v1_executor_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildStep.ProtoReflect.Descriptor instead.
+func (*BuildStep) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BuildStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuildStep) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *BuildStep) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *BuildStep) GetWorkDir() string {
+	if x != nil {
+		return x.WorkDir
+	}
+	return ""
+}
+
+func (x *BuildStep) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *BuildStep) GetContinueOnError() bool {
+	if x != nil {
+		return x.ContinueOnError
+	}
+	return false
+}
+
+func (x *BuildStep) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *BuildStep) GetProgressPattern() string {
+	if x != nil {
+		return x.ProgressPattern
+	}
+	return ""
+}
+
+func (x *BuildStep) GetFailOnStderr() bool {
+	if x != nil {
+		return x.FailOnStderr
+	}
+	return false
+}
+
+func (x *BuildStep) GetLogArgs() bool {
+	if x != nil && x.LogArgs != nil {
+		return *x.LogArgs
+	}
+	return false
+}
+
+func (x *BuildStep) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *BuildStep) GetRetryBackoffSeconds() int32 {
+	if x != nil {
+		return x.RetryBackoffSeconds
+	}
+	return 0
+}
+
+func (x *BuildStep) GetRetryOnExitCodes() []int32 {
+	if x != nil {
+		return x.RetryOnExitCodes
+	}
+	return nil
+}
+
+func (x *BuildStep) GetTimeoutFraction() float64 {
+	if x != nil {
+		return x.TimeoutFraction
+	}
+	return 0
+}
+
+func (x *BuildStep) GetShellCommand() string {
+	if x != nil {
+		return x.ShellCommand
+	}
+	return ""
+}
+
+func (x *BuildStep) GetShellPath() string {
+	if x != nil {
+		return x.ShellPath
+	}
+	return ""
+}
+
+func (x *BuildStep) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *BuildStep) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *BuildStep) GetIoPriority() int32 {
+	if x != nil && x.IoPriority != nil {
+		return *x.IoPriority
+	}
+	return 0
+}
+
+func (x *BuildStep) GetExpectStdoutContains() string {
+	if x != nil {
+		return x.ExpectStdoutContains
+	}
+	return ""
+}
+
+func (x *BuildStep) GetExpectStdoutRegex() string {
+	if x != nil {
+		return x.ExpectStdoutRegex
+	}
+	return ""
+}
+
+func (x *BuildStep) GetTailLines() int32 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *BuildStep) GetExpectedExitCodes() []int32 {
+	if x != nil {
+		return x.ExpectedExitCodes
+	}
+	return nil
+}
+
+func (x *BuildStep) GetBefore() []*StepCommand {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *BuildStep) GetAfter() []*StepCommand {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *BuildStep) GetSuccessFile() string {
+	if x != nil {
+		return x.SuccessFile
+	}
+	return ""
+}
+
+func (x *BuildStep) GetCaptureStdout() bool {
+	if x != nil && x.CaptureStdout != nil {
+		return *x.CaptureStdout
+	}
+	return false
+}
+
+func (x *BuildStep) GetCaptureStderr() bool {
+	if x != nil && x.CaptureStderr != nil {
+		return *x.CaptureStderr
+	}
+	return false
+}
+
+func (x *BuildStep) GetIsolateHome() bool {
+	if x != nil {
+		return x.IsolateHome
+	}
+	return false
+}
+
+func (x *BuildStep) GetEmitEnvSnapshot() bool {
+	if x != nil {
+		return x.EmitEnvSnapshot
+	}
+	return false
+}
+
+func (x *BuildStep) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *BuildStep) GetVerifyFiles() map[string]string {
+	if x != nil {
+		return x.VerifyFiles
+	}
+	return nil
+}
+
+func (x *BuildStep) GetOutputMode() OutputMode {
+	if x != nil {
+		return x.OutputMode
+	}
+	return OutputMode_OUTPUT_MODE_LINE_SCANNED
+}
+
+func (x *BuildStep) GetHashOutput() bool {
+	if x != nil {
+		return x.HashOutput
+	}
+	return false
+}
+
+func (x *BuildStep) GetHashStderr() bool {
+	if x != nil {
+		return x.HashStderr
+	}
+	return false
+}
+
+func (x *BuildStep) GetStopOnPattern() string {
+	if x != nil {
+		return x.StopOnPattern
+	}
+	return ""
+}
+
+func (x *BuildStep) GetMaxLifetimeSeconds() int32 {
+	if x != nil {
+		return x.MaxLifetimeSeconds
+	}
+	return 0
+}
+
+func (x *BuildStep) GetIdleTimeoutSeconds() int32 {
+	if x != nil {
+		return x.IdleTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *BuildStep) GetCaptureLastLineAs() string {
+	if x != nil {
+		return x.CaptureLastLineAs
+	}
+	return ""
+}
+
+func (x *BuildStep) GetProduces() []string {
+	if x != nil {
+		return x.Produces
+	}
+	return nil
+}
+
+func (x *BuildStep) GetConsumes() []string {
+	if x != nil {
+		return x.Consumes
+	}
+	return nil
+}
+
+func (x *BuildStep) GetRunIfChanged() []string {
+	if x != nil {
+		return x.RunIfChanged
+	}
+	return nil
+}
+
+func (x *BuildStep) GetTestReport() *TestReportSpec {
+	if x != nil {
+		return x.TestReport
+	}
+	return nil
+}
+
+// TestReportSpec declares a step's test report
+type TestReportSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format TestReportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=executor.v1.TestReportFormat" json:"format,omitempty"`
+	// Path is relative to the step's working directory
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *TestReportSpec) Reset() {
+	*x = TestReportSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestReportSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestReportSpec) ProtoMessage() {}
+
+func (x *TestReportSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestReportSpec.ProtoReflect.Descriptor instead.
+func (*TestReportSpec) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TestReportSpec) GetFormat() TestReportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TestReportFormat_TEST_REPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *TestReportSpec) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// TestReport summarizes a step's test report. Reading or parsing it does
+// not affect the step's result
+type TestReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   int32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Passed  int32 `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed  int32 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped int32 `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// Failures are the failed tests, at most 100. A go test package that
+	// failed without a failing test, e.g. to build, is reported as one
+	// failure with an empty name
+	Failures          []*TestFailure `protobuf:"bytes,5,rep,name=failures,proto3" json:"failures,omitempty"`
+	FailuresTruncated bool           `protobuf:"varint,6,opt,name=failures_truncated,json=failuresTruncated,proto3" json:"failures_truncated,omitempty"`
+	// Error is why the report could not be read or was only partly parsed;
+	// the counts cover what was parsed
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TestReport) Reset() {
+	*x = TestReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestReport) ProtoMessage() {}
+
+func (x *TestReport) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestReport.ProtoReflect.Descriptor instead.
+func (*TestReport) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TestReport) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TestReport) GetPassed() int32 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
+}
+
+func (x *TestReport) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *TestReport) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *TestReport) GetFailures() []*TestFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *TestReport) GetFailuresTruncated() bool {
+	if x != nil {
+		return x.FailuresTruncated
+	}
+	return false
+}
+
+func (x *TestReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// TestFailure is a failed test of a TestReport
+type TestFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Suite is the JUnit test suite or class, or the Go package
+	Suite string `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Message is the failure message and details, or the test's output, cut
+	// to 4KB
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *TestFailure) Reset() {
+	*x = TestFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestFailure) ProtoMessage() {}
+
+func (x *TestFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestFailure.ProtoReflect.Descriptor instead.
+func (*TestFailure) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TestFailure) GetSuite() string {
+	if x != nil {
+		return x.Suite
+	}
+	return ""
+}
+
+func (x *TestFailure) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// StepCommand is a before or after command of a BuildStep. It runs in the
+// step's image, if any, and must be allowed like any other tool
+type StepCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Tool and Args, or ShellCommand run with the step's shell_path
+	Tool         string   `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	Args         []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	ShellCommand string   `protobuf:"bytes,3,opt,name=shell_command,json=shellCommand,proto3" json:"shell_command,omitempty"`
+	// TimeoutSeconds defaults to the step's timeout_seconds
+	TimeoutSeconds int32 `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (x *StepCommand) Reset() {
+	*x = StepCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepCommand) ProtoMessage() {}
+
+func (x *StepCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepCommand.ProtoReflect.Descriptor instead.
+func (*StepCommand) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StepCommand) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *StepCommand) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *StepCommand) GetShellCommand() string {
+	if x != nil {
+		return x.ShellCommand
+	}
+	return ""
+}
+
+func (x *StepCommand) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+// PipelineRequest represents a pipeline execution request
+type PipelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the unique pipeline execution ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name is the pipeline name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// WorkspaceDir is the base workspace directory
+	WorkspaceDir string `protobuf:"bytes,3,opt,name=workspace_dir,json=workspaceDir,proto3" json:"workspace_dir,omitempty"`
+	// Steps are the pipeline steps to execute
+	Steps []*BuildStep `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Env are pipeline-level environment variables, overriding inherited
+	// variables with the same key. Step env takes precedence over them
+	Env []string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty"`
+	// TimeoutSeconds is the overall pipeline timeout
+	TimeoutSeconds int32 `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// FailOnStderr applies fail_on_stderr to every step
+	FailOnStderr bool `protobuf:"varint,7,opt,name=fail_on_stderr,json=failOnStderr,proto3" json:"fail_on_stderr,omitempty"`
+	// CaptureGitSha records the workspace's HEAD commit before the first step
+	// in git_sha and ${NECRO_GIT_SHA}
+	CaptureGitSha bool `protobuf:"varint,8,opt,name=capture_git_sha,json=captureGitSha,proto3" json:"capture_git_sha,omitempty"`
+	// ChangedFiles are the paths, relative to the repository root, changed by
+	// the commits being built. Steps with run_if_changed globs matching none
+	// of them are skipped. Empty = every step runs
+	ChangedFiles []string `protobuf:"bytes,9,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"`
+	// JobKey names the logical job this pipeline runs and FenceToken is the
+	// scheduler's lease for it, increasing with every (re)scheduling. The
+	// server keeps the highest token seen per job key and rejects requests
+	// with a lower one with FAILED_PRECONDITION (reason STALE_FENCE_TOKEN), so
+	// a delayed duplicate cannot start after a newer run. Requests with the
+	// highest token are accepted, as one holder may send several. Tokens are
+	// checked when a request arrives, not while it runs, are scoped to the
+	// caller's tenant, and are forgotten executor.fence_retention seconds after
+	// they were last seen. 0 = unfenced; job_key is required otherwise
+	JobKey     string `protobuf:"bytes,10,opt,name=job_key,json=jobKey,proto3" json:"job_key,omitempty"`
+	FenceToken uint64 `protobuf:"varint,11,opt,name=fence_token,json=fenceToken,proto3" json:"fence_token,omitempty"`
+}
+
+func (x *PipelineRequest) Reset() {
+	*x = PipelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineRequest) ProtoMessage() {}
+
+func (x *PipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineRequest.ProtoReflect.Descriptor instead.
+func (*PipelineRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PipelineRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PipelineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PipelineRequest) GetWorkspaceDir() string {
+	if x != nil {
+		return x.WorkspaceDir
+	}
+	return ""
+}
+
+func (x *PipelineRequest) GetSteps() []*BuildStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *PipelineRequest) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *PipelineRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *PipelineRequest) GetFailOnStderr() bool {
+	if x != nil {
+		return x.FailOnStderr
+	}
+	return false
+}
+
+func (x *PipelineRequest) GetCaptureGitSha() bool {
+	if x != nil {
+		return x.CaptureGitSha
+	}
+	return false
+}
+
+func (x *PipelineRequest) GetChangedFiles() []string {
+	if x != nil {
+		return x.ChangedFiles
+	}
+	return nil
+}
+
+func (x *PipelineRequest) GetJobKey() string {
+	if x != nil {
+		return x.JobKey
+	}
+	return ""
+}
+
+func (x *PipelineRequest) GetFenceToken() uint64 {
+	if x != nil {
+		return x.FenceToken
+	}
+	return 0
+}
+
+// StepResult contains the result of a single step
+type StepResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is the step name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// StepIndex is the step position (0-based)
+	StepIndex int32 `protobuf:"varint,2,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	// ExecuteResult contains the execution details
+	ExecuteResult *ExecuteResponse `protobuf:"bytes,3,opt,name=execute_result,json=executeResult,proto3" json:"execute_result,omitempty"`
+	// Skipped indicates if the step was skipped
+	Skipped bool `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// SkipReason is why the step was skipped
+	SkipReason string `protobuf:"bytes,5,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+	// OutputTruncated indicates the step's stdout/stderr were cut short in the
+	// aggregate pipeline response to stay within max_pipeline_output_bytes
+	OutputTruncated bool `protobuf:"varint,6,opt,name=output_truncated,json=outputTruncated,proto3" json:"output_truncated,omitempty"`
+	// Attempts is how many times the step was run, including retries
+	Attempts int32 `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Timing breakdown of the step's last attempt, see ExecuteResponse
+	QueueWaitMs int64 `protobuf:"varint,8,opt,name=queue_wait_ms,json=queueWaitMs,proto3" json:"queue_wait_ms,omitempty"`
+	StartupMs   int64 `protobuf:"varint,9,opt,name=startup_ms,json=startupMs,proto3" json:"startup_ms,omitempty"`
+	RunMs       int64 `protobuf:"varint,10,opt,name=run_ms,json=runMs,proto3" json:"run_ms,omitempty"`
+	// Results of the step's before and after commands, in order. Before
+	// commands after a failing one are not run and have no result
+	BeforeResults []*ExecuteResponse `protobuf:"bytes,11,rep,name=before_results,json=beforeResults,proto3" json:"before_results,omitempty"`
+	AfterResults  []*ExecuteResponse `protobuf:"bytes,12,rep,name=after_results,json=afterResults,proto3" json:"after_results,omitempty"`
+	// TestReport is the parsed test_report of the step, if it declares one
+	// and its command ran
+	TestReport *TestReport `protobuf:"bytes,13,opt,name=test_report,json=testReport,proto3" json:"test_report,omitempty"`
+}
+
+func (x *StepResult) Reset() {
+	*x = StepResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepResult) ProtoMessage() {}
+
+func (x *StepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepResult.ProtoReflect.Descriptor instead.
+func (*StepResult) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StepResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StepResult) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+func (x *StepResult) GetExecuteResult() *ExecuteResponse {
+	if x != nil {
+		return x.ExecuteResult
+	}
+	return nil
+}
+
+func (x *StepResult) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *StepResult) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
+}
+
+func (x *StepResult) GetOutputTruncated() bool {
+	if x != nil {
+		return x.OutputTruncated
+	}
+	return false
+}
+
+func (x *StepResult) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *StepResult) GetQueueWaitMs() int64 {
+	if x != nil {
+		return x.QueueWaitMs
+	}
+	return 0
+}
+
+func (x *StepResult) GetStartupMs() int64 {
+	if x != nil {
+		return x.StartupMs
+	}
+	return 0
+}
+
+func (x *StepResult) GetRunMs() int64 {
+	if x != nil {
+		return x.RunMs
+	}
+	return 0
+}
+
+func (x *StepResult) GetBeforeResults() []*ExecuteResponse {
+	if x != nil {
+		return x.BeforeResults
+	}
+	return nil
+}
+
+func (x *StepResult) GetAfterResults() []*ExecuteResponse {
+	if x != nil {
+		return x.AfterResults
+	}
+	return nil
+}
+
+func (x *StepResult) GetTestReport() *TestReport {
+	if x != nil {
+		return x.TestReport
+	}
+	return nil
+}
+
+// PipelineResponse contains the result of a pipeline execution
+type PipelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PipelineId is the pipeline execution ID
+	PipelineId string `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	// Name is the pipeline name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Success indicates if all steps completed successfully
+	Success bool `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// StepResults contains results for each step
+	StepResults []*StepResult `protobuf:"bytes,4,rep,name=step_results,json=stepResults,proto3" json:"step_results,omitempty"`
+	// TotalDurationMs is the overall pipeline duration in milliseconds
+	TotalDurationMs int64 `protobuf:"varint,5,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
+	// StartedAt is when the pipeline started
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// EndedAt is when the pipeline completed
+	EndedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	// FailedStep is the name of the first failed step (if any)
+	FailedStep string `protobuf:"bytes,8,opt,name=failed_step,json=failedStep,proto3" json:"failed_step,omitempty"`
+	// TotalSteps is the total number of steps
+	TotalSteps int32 `protobuf:"varint,9,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
+	// CompletedSteps is the number of steps that ran and succeeded
+	CompletedSteps int32 `protobuf:"varint,10,opt,name=completed_steps,json=completedSteps,proto3" json:"completed_steps,omitempty"`
+	// Timing breakdown summed over all steps, see StepResult
+	QueueWaitMs int64 `protobuf:"varint,11,opt,name=queue_wait_ms,json=queueWaitMs,proto3" json:"queue_wait_ms,omitempty"`
+	StartupMs   int64 `protobuf:"varint,12,opt,name=startup_ms,json=startupMs,proto3" json:"startup_ms,omitempty"`
+	RunMs       int64 `protobuf:"varint,13,opt,name=run_ms,json=runMs,proto3" json:"run_ms,omitempty"`
+	// GitSha is the workspace's HEAD commit when capture_git_sha was set, or
+	// empty if the workspace is not a git repository
+	GitSha string `protobuf:"bytes,14,opt,name=git_sha,json=gitSha,proto3" json:"git_sha,omitempty"`
+	// StopReason is why the pipeline stopped running steps
+	StopReason StopReason `protobuf:"varint,15,opt,name=stop_reason,json=stopReason,proto3,enum=executor.v1.StopReason" json:"stop_reason,omitempty"`
+	// ExecutionGraph describes how the steps that ran were scheduled
+	ExecutionGraph *ExecutionGraph `protobuf:"bytes,16,opt,name=execution_graph,json=executionGraph,proto3" json:"execution_graph,omitempty"`
+	// Outputs are the variables captured by steps with capture_last_line_as
+	Outputs map[string]string `protobuf:"bytes,17,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// AttemptedSteps is the number of steps that ran, whether or not they
+	// succeeded. Skipped steps are left out
+	AttemptedSteps int32 `protobuf:"varint,18,opt,name=attempted_steps,json=attemptedSteps,proto3" json:"attempted_steps,omitempty"`
+}
+
+func (x *PipelineResponse) Reset() {
+	*x = PipelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineResponse) ProtoMessage() {}
+
+func (x *PipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineResponse.ProtoReflect.Descriptor instead.
+func (*PipelineResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PipelineResponse) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *PipelineResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PipelineResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PipelineResponse) GetStepResults() []*StepResult {
+	if x != nil {
+		return x.StepResults
+	}
+	return nil
+}
+
+func (x *PipelineResponse) GetTotalDurationMs() int64 {
+	if x != nil {
+		return x.TotalDurationMs
+	}
+	return 0
+}
+
+func (x *PipelineResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *PipelineResponse) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *PipelineResponse) GetFailedStep() string {
+	if x != nil {
+		return x.FailedStep
+	}
+	return ""
+}
+
+func (x *PipelineResponse) GetTotalSteps() int32 {
+	if x != nil {
+		return x.TotalSteps
+	}
+	return 0
+}
+
+func (x *PipelineResponse) GetCompletedSteps() int32 {
+	if x != nil {
+		return x.CompletedSteps
+	}
+	return 0
+}
+
+func (x *PipelineResponse) GetQueueWaitMs() int64 {
+	if x != nil {
+		return x.QueueWaitMs
+	}
+	return 0
+}
+
+func (x *PipelineResponse) GetStartupMs() int64 {
+	if x != nil {
+		return x.StartupMs
+	}
+	return 0
+}
+
+func (x *PipelineResponse) GetRunMs() int64 {
+	if x != nil {
+		return x.RunMs
+	}
+	return 0
+}
+
+func (x *PipelineResponse) GetGitSha() string {
+	if x != nil {
+		return x.GitSha
+	}
+	return ""
+}
+
+func (x *PipelineResponse) GetStopReason() StopReason {
+	if x != nil {
+		return x.StopReason
+	}
+	return StopReason_STOP_REASON_UNSPECIFIED
+}
+
+func (x *PipelineResponse) GetExecutionGraph() *ExecutionGraph {
+	if x != nil {
+		return x.ExecutionGraph
+	}
+	return nil
+}
+
+func (x *PipelineResponse) GetOutputs() map[string]string {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *PipelineResponse) GetAttemptedSteps() int32 {
+	if x != nil {
+		return x.AttemptedSteps
+	}
+	return 0
+}
+
+// ExecutionGraph is the graph of the steps a pipeline ran, with their timing
+type ExecutionGraph struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nodes are the steps that ran, in the order they started. Skipped steps
+	// are left out
+	Nodes []*ExecutionNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// MaxParallelism is the largest number of steps that ran at once
+	MaxParallelism int32 `protobuf:"varint,2,opt,name=max_parallelism,json=maxParallelism,proto3" json:"max_parallelism,omitempty"`
+}
+
+func (x *ExecutionGraph) Reset() {
+	*x = ExecutionGraph{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionGraph) ProtoMessage() {}
+
+func (x *ExecutionGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionGraph.ProtoReflect.Descriptor instead.
+func (*ExecutionGraph) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExecutionGraph) GetNodes() []*ExecutionNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ExecutionGraph) GetMaxParallelism() int32 {
+	if x != nil {
+		return x.MaxParallelism
+	}
+	return 0
+}
+
+// ExecutionNode is one step of an ExecutionGraph
+type ExecutionNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StepIndex int32  `protobuf:"varint,2,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	// DependsOn are the step indexes that had to finish before this step
+	// started
+	DependsOn []int32 `protobuf:"varint,3,rep,packed,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	// StartedAt and EndedAt span the whole step, including retries and its
+	// before and after commands
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	DurationMs int64                  `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Success    bool                   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *ExecutionNode) Reset() {
+	*x = ExecutionNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionNode) ProtoMessage() {}
+
+func (x *ExecutionNode) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionNode.ProtoReflect.Descriptor instead.
+func (*ExecutionNode) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExecutionNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecutionNode) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+func (x *ExecutionNode) GetDependsOn() []int32 {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *ExecutionNode) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ExecutionNode) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *ExecutionNode) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ExecutionNode) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// PipelineStreamResponse streams pipeline execution progress
+type PipelineStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*PipelineStreamResponse_StepStarted
+	//	*PipelineStreamResponse_StepOutput
+	//	*PipelineStreamResponse_StepCompleted
+	//	*PipelineStreamResponse_PipelineCompleted
+	//	*PipelineStreamResponse_Limit
+	//	*PipelineStreamResponse_Deadline
+	//	*PipelineStreamResponse_Progress
+	//	*PipelineStreamResponse_EnvSnapshot
+	//	*PipelineStreamResponse_PipelineTimeout
+	//	*PipelineStreamResponse_ImagePull
+	//	*PipelineStreamResponse_RateLimited
+	Event isPipelineStreamResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *PipelineStreamResponse) Reset() {
+	*x = PipelineStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStreamResponse) ProtoMessage() {}
+
+func (x *PipelineStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStreamResponse.ProtoReflect.Descriptor instead.
+func (*PipelineStreamResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{22}
+}
+
+func (m *PipelineStreamResponse) GetEvent() isPipelineStreamResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *PipelineStreamResponse) GetStepStarted() *StepStartedEvent {
+	if x, ok := x.GetEvent().(*PipelineStreamResponse_StepStarted); ok {
+		return x.StepStarted
+	}
+	return nil
+}
+
+func (x *PipelineStreamResponse) GetStepOutput() *StepOutputEvent {
+	if x, ok := x.GetEvent().(*PipelineStreamResponse_StepOutput); ok {
+		return x.StepOutput
+	}
+	return nil
+}
+
+func (x *PipelineStreamResponse) GetStepCompleted() *StepResult {
+	if x, ok := x.GetEvent().(*PipelineStreamResponse_StepCompleted); ok {
+		return x.StepCompleted
+	}
+	return nil
+}
+
+func (x *PipelineStreamResponse) GetPipelineCompleted() *PipelineResponse {
+	if x, ok := x.GetEvent().(*PipelineStreamResponse_PipelineCompleted); ok {
+		return x.PipelineCompleted
+	}
+	return nil
+}
+
+func (x *PipelineStreamResponse) GetLimit() *LimitEvent {
+	if x, ok := x.GetEvent().(*PipelineStreamResponse_Limit); ok {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *PipelineStreamResponse) GetDeadline() *DeadlineEvent {
+	if x, ok := x.GetEvent().(*PipelineStreamResponse_Deadline); ok {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *PipelineStreamResponse) GetProgress() *ProgressEvent {
+	if x, ok := x.GetEvent().(*PipelineStreamResponse_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *PipelineStreamResponse) GetEnvSnapshot() *EnvSnapshotEvent {
+	if x, ok := x.GetEvent().(*PipelineStreamResponse_EnvSnapshot); ok {
+		return x.EnvSnapshot
+	}
+	return nil
+}
+
+func (x *PipelineStreamResponse) GetPipelineTimeout() *PipelineTimeoutEvent {
+	if x, ok := x.GetEvent().(*PipelineStreamResponse_PipelineTimeout); ok {
+		return x.PipelineTimeout
+	}
+	return nil
+}
+
+func (x *PipelineStreamResponse) GetImagePull() *ImagePullEvent {
+	if x, ok := x.GetEvent().(*PipelineStreamResponse_ImagePull); ok {
+		return x.ImagePull
+	}
+	return nil
+}
+
+func (x *PipelineStreamResponse) GetRateLimited() *RateLimitedEvent {
+	if x, ok := x.GetEvent().(*PipelineStreamResponse_RateLimited); ok {
+		return x.RateLimited
+	}
+	return nil
+}
+
+type isPipelineStreamResponse_Event interface {
+	isPipelineStreamResponse_Event()
+}
+
+type PipelineStreamResponse_StepStarted struct {
+	// StepStarted is sent when a step begins
+	StepStarted *StepStartedEvent `protobuf:"bytes,1,opt,name=step_started,json=stepStarted,proto3,oneof"`
+}
+
+type PipelineStreamResponse_StepOutput struct {
+	// StepOutput is sent for step output lines
+	StepOutput *StepOutputEvent `protobuf:"bytes,2,opt,name=step_output,json=stepOutput,proto3,oneof"`
+}
+
+type PipelineStreamResponse_StepCompleted struct {
+	// StepCompleted is sent when a step finishes
+	StepCompleted *StepResult `protobuf:"bytes,3,opt,name=step_completed,json=stepCompleted,proto3,oneof"`
+}
+
+type PipelineStreamResponse_PipelineCompleted struct {
+	// PipelineCompleted is sent when the entire pipeline finishes
+	PipelineCompleted *PipelineResponse `protobuf:"bytes,4,opt,name=pipeline_completed,json=pipelineCompleted,proto3,oneof"`
+}
+
+type PipelineStreamResponse_Limit struct {
+	// Limit is sent when a limit is enforced on a step or the pipeline
+	Limit *LimitEvent `protobuf:"bytes,5,opt,name=limit,proto3,oneof"`
+}
+
+type PipelineStreamResponse_Deadline struct {
+	// Deadline is sent periodically while a step runs
+	Deadline *DeadlineEvent `protobuf:"bytes,6,opt,name=deadline,proto3,oneof"`
+}
+
+type PipelineStreamResponse_Progress struct {
+	// Progress is sent for step output lines matching progress_pattern
+	Progress *ProgressEvent `protobuf:"bytes,7,opt,name=progress,proto3,oneof"`
+}
+
+type PipelineStreamResponse_EnvSnapshot struct {
+	// EnvSnapshot is sent when a step with emit_env_snapshot starts
+	EnvSnapshot *EnvSnapshotEvent `protobuf:"bytes,8,opt,name=env_snapshot,json=envSnapshot,proto3,oneof"`
+}
+
+type PipelineStreamResponse_PipelineTimeout struct {
+	// PipelineTimeout is sent when the pipeline's timeout_seconds expires,
+	// before the results of the interrupted and skipped steps
+	PipelineTimeout *PipelineTimeoutEvent `protobuf:"bytes,9,opt,name=pipeline_timeout,json=pipelineTimeout,proto3,oneof"`
+}
+
+type PipelineStreamResponse_ImagePull struct {
+	// ImagePull is sent as each step image is pre-pulled, before the first
+	// step starts
+	ImagePull *ImagePullEvent `protobuf:"bytes,10,opt,name=image_pull,json=imagePull,proto3,oneof"`
+}
+
+type PipelineStreamResponse_RateLimited struct {
+	// RateLimited is sent after a second in which a step's output exceeded
+	// executor.max_output_lines_per_sec
+	RateLimited *RateLimitedEvent `protobuf:"bytes,11,opt,name=rate_limited,json=rateLimited,proto3,oneof"`
+}
+
+func (*PipelineStreamResponse_StepStarted) isPipelineStreamResponse_Event() {}
+
+func (*PipelineStreamResponse_StepOutput) isPipelineStreamResponse_Event() {}
+
+func (*PipelineStreamResponse_StepCompleted) isPipelineStreamResponse_Event() {}
+
+func (*PipelineStreamResponse_PipelineCompleted) isPipelineStreamResponse_Event() {}
+
+func (*PipelineStreamResponse_Limit) isPipelineStreamResponse_Event() {}
+
+func (*PipelineStreamResponse_Deadline) isPipelineStreamResponse_Event() {}
+
+func (*PipelineStreamResponse_Progress) isPipelineStreamResponse_Event() {}
+
+func (*PipelineStreamResponse_EnvSnapshot) isPipelineStreamResponse_Event() {}
+
+func (*PipelineStreamResponse_PipelineTimeout) isPipelineStreamResponse_Event() {}
+
+func (*PipelineStreamResponse_ImagePull) isPipelineStreamResponse_Event() {}
+
+func (*PipelineStreamResponse_RateLimited) isPipelineStreamResponse_Event() {}
+
+// ImagePullEvent reports the progress of pre-pulling one step image
+type ImagePullEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image string         `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	State ImagePullState `protobuf:"varint,2,opt,name=state,proto3,enum=executor.v1.ImagePullState" json:"state,omitempty"`
+	// Error is why the pull failed. The steps using the image still run
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// DurationMs is how long the pull took, once it finished
+	DurationMs int64 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+}
+
+func (x *ImagePullEvent) Reset() {
+	*x = ImagePullEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImagePullEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagePullEvent) ProtoMessage() {}
+
+func (x *ImagePullEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagePullEvent.ProtoReflect.Descriptor instead.
+func (*ImagePullEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImagePullEvent) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ImagePullEvent) GetState() ImagePullState {
+	if x != nil {
+		return x.State
+	}
+	return ImagePullState_IMAGE_PULL_STATE_UNSPECIFIED
+}
+
+func (x *ImagePullEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImagePullEvent) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// PipelineTimeoutEvent reports that a pipeline exceeded its overall timeout,
+// as opposed to a step exceeding its own
+type PipelineTimeoutEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeoutSeconds int32 `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	ElapsedMs      int64 `protobuf:"varint,2,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	// ActiveStepName and ActiveStepIndex identify the step that was running
+	// when the timeout expired. The index is -1 if it expired between steps
+	ActiveStepName  string `protobuf:"bytes,3,opt,name=active_step_name,json=activeStepName,proto3" json:"active_step_name,omitempty"`
+	ActiveStepIndex int32  `protobuf:"varint,4,opt,name=active_step_index,json=activeStepIndex,proto3" json:"active_step_index,omitempty"`
+}
+
+func (x *PipelineTimeoutEvent) Reset() {
+	*x = PipelineTimeoutEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineTimeoutEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineTimeoutEvent) ProtoMessage() {}
+
+func (x *PipelineTimeoutEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineTimeoutEvent.ProtoReflect.Descriptor instead.
+func (*PipelineTimeoutEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PipelineTimeoutEvent) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *PipelineTimeoutEvent) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+func (x *PipelineTimeoutEvent) GetActiveStepName() string {
+	if x != nil {
+		return x.ActiveStepName
+	}
+	return ""
+}
+
+func (x *PipelineTimeoutEvent) GetActiveStepIndex() int32 {
+	if x != nil {
+		return x.ActiveStepIndex
+	}
+	return 0
+}
+
+// DeadlineEvent reports the time left before a command is killed for timing out
+type DeadlineEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RemainingMs is the time left until the deadline in milliseconds
+	RemainingMs int64 `protobuf:"varint,1,opt,name=remaining_ms,json=remainingMs,proto3" json:"remaining_ms,omitempty"`
+	// Deadline is when the command will time out
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// StepName and StepIndex identify the pipeline step, if any
+	StepName  string `protobuf:"bytes,3,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
+	StepIndex int32  `protobuf:"varint,4,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	// BytesSoFar counts the stdout and stderr output read so far
+	BytesSoFar int64 `protobuf:"varint,5,opt,name=bytes_so_far,json=bytesSoFar,proto3" json:"bytes_so_far,omitempty"`
+}
+
+func (x *DeadlineEvent) Reset() {
+	*x = DeadlineEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadlineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlineEvent) ProtoMessage() {}
+
+func (x *DeadlineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlineEvent.ProtoReflect.Descriptor instead.
+func (*DeadlineEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeadlineEvent) GetRemainingMs() int64 {
+	if x != nil {
+		return x.RemainingMs
+	}
+	return 0
+}
+
+func (x *DeadlineEvent) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *DeadlineEvent) GetStepName() string {
+	if x != nil {
+		return x.StepName
+	}
+	return ""
+}
+
+func (x *DeadlineEvent) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+func (x *DeadlineEvent) GetBytesSoFar() int64 {
+	if x != nil {
+		return x.BytesSoFar
+	}
+	return 0
+}
+
+// ProgressEvent reports progress parsed from a command's output
+type ProgressEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Percent is the parsed completion percentage, between 0 and 100
+	Percent float64 `protobuf:"fixed64,1,opt,name=percent,proto3" json:"percent,omitempty"`
+	// Line is the output line the progress was parsed from
+	Line string `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+	// Stderr is true when the line came from stderr
+	Stderr bool `protobuf:"varint,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// StepName and StepIndex identify the pipeline step, if any
+	StepName  string `protobuf:"bytes,4,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
+	StepIndex int32  `protobuf:"varint,5,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+}
+
+func (x *ProgressEvent) Reset() {
+	*x = ProgressEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgressEvent) ProtoMessage() {}
+
+func (x *ProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgressEvent.ProtoReflect.Descriptor instead.
+func (*ProgressEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProgressEvent) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *ProgressEvent) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *ProgressEvent) GetStderr() bool {
+	if x != nil {
+		return x.Stderr
+	}
+	return false
+}
+
+func (x *ProgressEvent) GetStepName() string {
+	if x != nil {
+		return x.StepName
+	}
+	return ""
+}
+
+func (x *ProgressEvent) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+// RateLimitedEvent reports the lines of one output stream held back during
+// a second in which it exceeded executor.max_output_lines_per_sec
+type RateLimitedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Stderr is true for lines from stderr
+	Stderr bool `protobuf:"varint,1,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// Dropped counts lines discarded with the drop policy. They are neither
+	// streamed nor captured
+	Dropped int64 `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// Coalesced counts lines joined into one output line with the coalesce
+	// policy, sent once the second ends
+	Coalesced int64 `protobuf:"varint,3,opt,name=coalesced,proto3" json:"coalesced,omitempty"`
+	// MaxLinesPerSec is the configured rate
+	MaxLinesPerSec int32 `protobuf:"varint,4,opt,name=max_lines_per_sec,json=maxLinesPerSec,proto3" json:"max_lines_per_sec,omitempty"`
+	// StepName and StepIndex identify the pipeline step, if any
+	StepName  string `protobuf:"bytes,5,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
+	StepIndex int32  `protobuf:"varint,6,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+}
+
+func (x *RateLimitedEvent) Reset() {
+	*x = RateLimitedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitedEvent) ProtoMessage() {}
+
+func (x *RateLimitedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitedEvent.ProtoReflect.Descriptor instead.
+func (*RateLimitedEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RateLimitedEvent) GetStderr() bool {
+	if x != nil {
+		return x.Stderr
+	}
+	return false
+}
+
+func (x *RateLimitedEvent) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *RateLimitedEvent) GetCoalesced() int64 {
+	if x != nil {
+		return x.Coalesced
+	}
+	return 0
+}
+
+func (x *RateLimitedEvent) GetMaxLinesPerSec() int32 {
+	if x != nil {
+		return x.MaxLinesPerSec
+	}
+	return 0
+}
+
+func (x *RateLimitedEvent) GetStepName() string {
+	if x != nil {
+		return x.StepName
+	}
+	return ""
+}
+
+func (x *RateLimitedEvent) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+// StepStartedEvent is sent when a step begins execution
+type StepStartedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StepName   string                 `protobuf:"bytes,1,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
+	StepIndex  int32                  `protobuf:"varint,2,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	TotalSteps int32                  `protobuf:"varint,3,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+}
+
+func (x *StepStartedEvent) Reset() {
+	*x = StepStartedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepStartedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepStartedEvent) ProtoMessage() {}
+
+func (x *StepStartedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepStartedEvent.ProtoReflect.Descriptor instead.
+func (*StepStartedEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StepStartedEvent) GetStepName() string {
+	if x != nil {
+		return x.StepName
+	}
+	return ""
+}
+
+func (x *StepStartedEvent) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+func (x *StepStartedEvent) GetTotalSteps() int32 {
+	if x != nil {
+		return x.TotalSteps
+	}
+	return 0
+}
+
+func (x *StepStartedEvent) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+// StepOutputEvent streams step output in real-time
+type StepOutputEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StepName  string `protobuf:"bytes,1,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
+	StepIndex int32  `protobuf:"varint,2,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	// Types that are assignable to Output:
+	//
+	//	*StepOutputEvent_StdoutLine
+	//	*StepOutputEvent_StderrLine
+	//	*StepOutputEvent_StdoutChunk
+	//	*StepOutputEvent_StderrChunk
+	Output isStepOutputEvent_Output `protobuf_oneof:"output"`
+	// Phase is "before" or "after" for output of the step's before and after
+	// commands, empty for the step's command
+	Phase string `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+}
+
+func (x *StepOutputEvent) Reset() {
+	*x = StepOutputEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepOutputEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepOutputEvent) ProtoMessage() {}
+
+func (x *StepOutputEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepOutputEvent.ProtoReflect.Descriptor instead.
+func (*StepOutputEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StepOutputEvent) GetStepName() string {
+	if x != nil {
+		return x.StepName
+	}
+	return ""
+}
+
+func (x *StepOutputEvent) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+func (m *StepOutputEvent) GetOutput() isStepOutputEvent_Output {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (x *StepOutputEvent) GetStdoutLine() string {
+	if x, ok := x.GetOutput().(*StepOutputEvent_StdoutLine); ok {
+		return x.StdoutLine
+	}
+	return ""
+}
+
+func (x *StepOutputEvent) GetStderrLine() string {
+	if x, ok := x.GetOutput().(*StepOutputEvent_StderrLine); ok {
+		return x.StderrLine
+	}
+	return ""
+}
+
+func (x *StepOutputEvent) GetStdoutChunk() []byte {
+	if x, ok := x.GetOutput().(*StepOutputEvent_StdoutChunk); ok {
+		return x.StdoutChunk
+	}
+	return nil
+}
+
+func (x *StepOutputEvent) GetStderrChunk() []byte {
+	if x, ok := x.GetOutput().(*StepOutputEvent_StderrChunk); ok {
+		return x.StderrChunk
+	}
+	return nil
+}
+
+func (x *StepOutputEvent) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+type isStepOutputEvent_Output interface {
+	isStepOutputEvent_Output()
+}
+
+type StepOutputEvent_StdoutLine struct {
+	StdoutLine string `protobuf:"bytes,3,opt,name=stdout_line,json=stdoutLine,proto3,oneof"`
+}
+
+type StepOutputEvent_StderrLine struct {
+	StderrLine string `protobuf:"bytes,4,opt,name=stderr_line,json=stderrLine,proto3,oneof"`
+}
+
+type StepOutputEvent_StdoutChunk struct {
+	// StdoutChunk and StderrChunk carry output of steps with
+	// OUTPUT_MODE_RAW_CHUNKS
+	StdoutChunk []byte `protobuf:"bytes,6,opt,name=stdout_chunk,json=stdoutChunk,proto3,oneof"`
+}
+
+type StepOutputEvent_StderrChunk struct {
+	StderrChunk []byte `protobuf:"bytes,7,opt,name=stderr_chunk,json=stderrChunk,proto3,oneof"`
+}
+
+func (*StepOutputEvent_StdoutLine) isStepOutputEvent_Output() {}
+
+func (*StepOutputEvent_StderrLine) isStepOutputEvent_Output() {}
+
+func (*StepOutputEvent_StdoutChunk) isStepOutputEvent_Output() {}
+
+func (*StepOutputEvent_StderrChunk) isStepOutputEvent_Output() {}
+
+// CancelRequest requests cancellation of a running process
+type CancelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessId string `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	// CancelSignal is the signal sent before the grace-period kill
+	// (e.g. SIGINT, SIGUSR1). Defaults to executor.default_cancel_signal
+	CancelSignal string `protobuf:"bytes,2,opt,name=cancel_signal,json=cancelSignal,proto3" json:"cancel_signal,omitempty"`
+	// WaitMs waits up to this long, at most 30s, for the process to exit
+	// before responding (0 = respond once it was signalled)
+	WaitMs int32 `protobuf:"varint,3,opt,name=wait_ms,json=waitMs,proto3" json:"wait_ms,omitempty"`
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CancelRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *CancelRequest) GetCancelSignal() string {
+	if x != nil {
+		return x.CancelSignal
+	}
+	return ""
+}
+
+func (x *CancelRequest) GetWaitMs() int32 {
+	if x != nil {
+		return x.WaitMs
+	}
+	return 0
+}
+
+// CancelResponse confirms process cancellation
+type CancelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// State is the process's state when the response was sent
+	State CancelState `protobuf:"varint,3,opt,name=state,proto3,enum=executor.v1.CancelState" json:"state,omitempty"`
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CancelResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelResponse) GetState() CancelState {
+	if x != nil {
+		return x.State
+	}
+	return CancelState_CANCEL_STATE_UNSPECIFIED
+}
+
+// CancelByToolRequest requests cancellation of all processes of a tool
+type CancelByToolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Tool matches processes whose tool, or the tool's file name, equals it
+	// ignoring case, so "docker" also matches "/usr/bin/docker"
+	Tool string `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	// CancelSignal is the signal sent before the grace-period kill, see
+	// CancelRequest
+	CancelSignal string `protobuf:"bytes,2,opt,name=cancel_signal,json=cancelSignal,proto3" json:"cancel_signal,omitempty"`
+}
+
+func (x *CancelByToolRequest) Reset() {
+	*x = CancelByToolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelByToolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelByToolRequest) ProtoMessage() {}
+
+func (x *CancelByToolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelByToolRequest.ProtoReflect.Descriptor instead.
+func (*CancelByToolRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CancelByToolRequest) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *CancelByToolRequest) GetCancelSignal() string {
+	if x != nil {
+		return x.CancelSignal
+	}
+	return ""
+}
+
+// CancelByToolResponse lists the processes that were cancelled
+type CancelByToolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessIds []string `protobuf:"bytes,1,rep,name=process_ids,json=processIds,proto3" json:"process_ids,omitempty"`
+}
+
+func (x *CancelByToolResponse) Reset() {
+	*x = CancelByToolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelByToolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelByToolResponse) ProtoMessage() {}
+
+func (x *CancelByToolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelByToolResponse.ProtoReflect.Descriptor instead.
+func (*CancelByToolResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelByToolResponse) GetProcessIds() []string {
+	if x != nil {
+		return x.ProcessIds
+	}
+	return nil
+}
+
+// CancelPipelineRequest requests cancellation of a running pipeline
+type CancelPipelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PipelineId string `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+}
+
+func (x *CancelPipelineRequest) Reset() {
+	*x = CancelPipelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPipelineRequest) ProtoMessage() {}
+
+func (x *CancelPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPipelineRequest.ProtoReflect.Descriptor instead.
+func (*CancelPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CancelPipelineRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+// CancelPipelineResponse confirms pipeline cancellation
+type CancelPipelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Number of processes that were cancelled
+	CancelledProcesses int32 `protobuf:"varint,3,opt,name=cancelled_processes,json=cancelledProcesses,proto3" json:"cancelled_processes,omitempty"`
+}
+
+func (x *CancelPipelineResponse) Reset() {
+	*x = CancelPipelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPipelineResponse) ProtoMessage() {}
+
+func (x *CancelPipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPipelineResponse.ProtoReflect.Descriptor instead.
+func (*CancelPipelineResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CancelPipelineResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelPipelineResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelPipelineResponse) GetCancelledProcesses() int32 {
+	if x != nil {
+		return x.CancelledProcesses
+	}
+	return 0
+}
+
+// GetProcessesRequest requests list of running processes
+type GetProcessesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Tool only lists processes of this tool, see CancelByToolRequest
+	Tool string `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+}
+
+func (x *GetProcessesRequest) Reset() {
+	*x = GetProcessesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProcessesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProcessesRequest) ProtoMessage() {}
+
+func (x *GetProcessesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProcessesRequest.ProtoReflect.Descriptor instead.
+func (*GetProcessesRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetProcessesRequest) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+// ProcessInfo contains information about a running process
+type ProcessInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessId  string                 `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	Tool       string                 `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`
+	Args       []string               `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	DurationMs int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// OsPid is the operating system process ID
+	OsPid int32 `protobuf:"varint,6,opt,name=os_pid,json=osPid,proto3" json:"os_pid,omitempty"`
+	// StdoutBytes and StderrBytes count the output read so far
+	StdoutBytes int64 `protobuf:"varint,7,opt,name=stdout_bytes,json=stdoutBytes,proto3" json:"stdout_bytes,omitempty"`
+	StderrBytes int64 `protobuf:"varint,8,opt,name=stderr_bytes,json=stderrBytes,proto3" json:"stderr_bytes,omitempty"`
+}
+
+func (x *ProcessInfo) Reset() {
+	*x = ProcessInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessInfo) ProtoMessage() {}
+
+func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ProcessInfo) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetOsPid() int32 {
+	if x != nil {
+		return x.OsPid
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetStdoutBytes() int64 {
+	if x != nil {
+		return x.StdoutBytes
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetStderrBytes() int64 {
+	if x != nil {
+		return x.StderrBytes
+	}
+	return 0
+}
+
+// GetProcessesResponse contains running process information
+type GetProcessesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Processes []*ProcessInfo `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
+	Count     int32          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetProcessesResponse) Reset() {
+	*x = GetProcessesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProcessesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProcessesResponse) ProtoMessage() {}
+
+func (x *GetProcessesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProcessesResponse.ProtoReflect.Descriptor instead.
+func (*GetProcessesResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetProcessesResponse) GetProcesses() []*ProcessInfo {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+func (x *GetProcessesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PingRequest requests a liveness check
+type PingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{39}
+}
+
+// PingResponse answers a PingRequest
+type PingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time is the server's current time
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PingResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// HealthRequest requests service health status
+type HealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HealthRequest) Reset() {
+	*x = HealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthRequest) ProtoMessage() {}
+
+func (x *HealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
+func (*HealthRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{41}
+}
+
+// HealthResponse contains service health information
+type HealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	RunningCount  int32                  `protobuf:"varint,3,opt,name=running_count,json=runningCount,proto3" json:"running_count,omitempty"`
+	MaxConcurrent int32                  `protobuf:"varint,4,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	UptimeSeconds float64                `protobuf:"fixed64,5,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	// Throttled indicates admission control is currently refusing new executions
+	Throttled bool `protobuf:"varint,7,opt,name=throttled,proto3" json:"throttled,omitempty"`
+	// ThrottleReason describes which threshold is exceeded
+	ThrottleReason string `protobuf:"bytes,8,opt,name=throttle_reason,json=throttleReason,proto3" json:"throttle_reason,omitempty"`
+	// Limits are the server's configured request and output limits
+	Limits *ServerLimits `protobuf:"bytes,9,opt,name=limits,proto3" json:"limits,omitempty"`
+	// ConnectionCount is the number of open client connections
+	ConnectionCount int32 `protobuf:"varint,10,opt,name=connection_count,json=connectionCount,proto3" json:"connection_count,omitempty"`
+	// GoroutineCount is the number of goroutines in the server
+	GoroutineCount int32 `protobuf:"varint,11,opt,name=goroutine_count,json=goroutineCount,proto3" json:"goroutine_count,omitempty"`
+	// OpenFdCount is the number of open file descriptors, from /proc/self/fd
+	// (0 where unavailable)
+	OpenFdCount int32 `protobuf:"varint,12,opt,name=open_fd_count,json=openFdCount,proto3" json:"open_fd_count,omitempty"`
+	// ToolChecks are the latest results of the tool health probes that have
+	// run, by tool name. Status is "degraded" while one of them fails
+	ToolChecks []*CheckToolResponse `protobuf:"bytes,13,rep,name=tool_checks,json=toolChecks,proto3" json:"tool_checks,omitempty"`
+}
+
+func (x *HealthResponse) Reset() {
+	*x = HealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResponse) ProtoMessage() {}
+
+func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *HealthResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetRunningCount() int32 {
+	if x != nil {
+		return x.RunningCount
+	}
+	return 0
+}
+
+func (x *HealthResponse) GetMaxConcurrent() int32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *HealthResponse) GetUptimeSeconds() float64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *HealthResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *HealthResponse) GetThrottled() bool {
+	if x != nil {
+		return x.Throttled
+	}
+	return false
+}
+
+func (x *HealthResponse) GetThrottleReason() string {
+	if x != nil {
+		return x.ThrottleReason
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetLimits() *ServerLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *HealthResponse) GetConnectionCount() int32 {
+	if x != nil {
+		return x.ConnectionCount
+	}
+	return 0
+}
+
+func (x *HealthResponse) GetGoroutineCount() int32 {
+	if x != nil {
+		return x.GoroutineCount
+	}
+	return 0
+}
+
+func (x *HealthResponse) GetOpenFdCount() int32 {
+	if x != nil {
+		return x.OpenFdCount
+	}
+	return 0
+}
+
+func (x *HealthResponse) GetToolChecks() []*CheckToolResponse {
+	if x != nil {
+		return x.ToolChecks
+	}
+	return nil
+}
+
+// ServerLimits describes the limits a client can check requests against
+// before sending them. 0 means unlimited.
+type ServerLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MaxMessageBytes is the largest request or response message accepted
+	MaxMessageBytes int64 `protobuf:"varint,1,opt,name=max_message_bytes,json=maxMessageBytes,proto3" json:"max_message_bytes,omitempty"`
+	// MaxOutputBytes caps captured stdout and stderr per execution
+	MaxOutputBytes int64 `protobuf:"varint,2,opt,name=max_output_bytes,json=maxOutputBytes,proto3" json:"max_output_bytes,omitempty"`
+	// MaxLineBytes is the longest output line kept before it is cut
+	MaxLineBytes int64 `protobuf:"varint,3,opt,name=max_line_bytes,json=maxLineBytes,proto3" json:"max_line_bytes,omitempty"`
+	// MaxPipelineOutputBytes caps captured output across a pipeline response
+	MaxPipelineOutputBytes int64 `protobuf:"varint,4,opt,name=max_pipeline_output_bytes,json=maxPipelineOutputBytes,proto3" json:"max_pipeline_output_bytes,omitempty"`
+	// MaxConcurrentStreams caps open streaming calls
+	MaxConcurrentStreams int32 `protobuf:"varint,5,opt,name=max_concurrent_streams,json=maxConcurrentStreams,proto3" json:"max_concurrent_streams,omitempty"`
+	// MaxConnections caps open client connections
+	MaxConnections int32 `protobuf:"varint,6,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	// MaxPipelineSteps caps the steps of a pipeline request
+	MaxPipelineSteps int32 `protobuf:"varint,7,opt,name=max_pipeline_steps,json=maxPipelineSteps,proto3" json:"max_pipeline_steps,omitempty"`
+}
+
+func (x *ServerLimits) Reset() {
+	*x = ServerLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerLimits) ProtoMessage() {}
+
+func (x *ServerLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerLimits.ProtoReflect.Descriptor instead.
+func (*ServerLimits) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ServerLimits) GetMaxMessageBytes() int64 {
+	if x != nil {
+		return x.MaxMessageBytes
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetMaxOutputBytes() int64 {
+	if x != nil {
+		return x.MaxOutputBytes
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetMaxLineBytes() int64 {
+	if x != nil {
+		return x.MaxLineBytes
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetMaxPipelineOutputBytes() int64 {
+	if x != nil {
+		return x.MaxPipelineOutputBytes
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetMaxConcurrentStreams() int32 {
+	if x != nil {
+		return x.MaxConcurrentStreams
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetMaxConnections() int32 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetMaxPipelineSteps() int32 {
+	if x != nil {
+		return x.MaxPipelineSteps
+	}
+	return 0
+}
+
+// AcquireWorkspaceRequest names the workspace to acquire
+type AcquireWorkspaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name may contain letters, digits, '.', '_' and '-', and must not start
+	// with '.'. With tenant isolation the workspace lies in the caller's
+	// tenant directory
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// LeaseId renews this lease on the workspace instead of taking a new one
+	LeaseId string `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+}
+
+func (x *AcquireWorkspaceRequest) Reset() {
+	*x = AcquireWorkspaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcquireWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireWorkspaceRequest) ProtoMessage() {}
+
+func (x *AcquireWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*AcquireWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AcquireWorkspaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcquireWorkspaceRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+// AcquireWorkspaceResponse describes an acquired workspace
+type AcquireWorkspaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path is the absolute workspace directory under workspace_base
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// References counts the leases and running executions holding the
+	// workspace, including this lease
+	References int32 `protobuf:"varint,2,opt,name=references,proto3" json:"references,omitempty"`
+	// Created is true if this call created the workspace
+	Created bool `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	// LeaseId must be passed to ReleaseWorkspace
+	LeaseId string `protobuf:"bytes,4,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// LeaseExpiresAt is when the lease is dropped unless renewed or released
+	// before (see executor.workspace_lease_ttl), unset if it never expires
+	LeaseExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lease_expires_at,json=leaseExpiresAt,proto3" json:"lease_expires_at,omitempty"`
+}
+
+func (x *AcquireWorkspaceResponse) Reset() {
+	*x = AcquireWorkspaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcquireWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireWorkspaceResponse) ProtoMessage() {}
+
+func (x *AcquireWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*AcquireWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AcquireWorkspaceResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AcquireWorkspaceResponse) GetReferences() int32 {
+	if x != nil {
+		return x.References
+	}
+	return 0
+}
+
+func (x *AcquireWorkspaceResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *AcquireWorkspaceResponse) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *AcquireWorkspaceResponse) GetLeaseExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LeaseExpiresAt
+	}
+	return nil
+}
+
+// ReleaseWorkspaceRequest names the workspace to release
+type ReleaseWorkspaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// LeaseId is the lease returned by AcquireWorkspace
+	LeaseId string `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+}
+
+func (x *ReleaseWorkspaceRequest) Reset() {
+	*x = ReleaseWorkspaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseWorkspaceRequest) ProtoMessage() {}
+
+func (x *ReleaseWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReleaseWorkspaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReleaseWorkspaceRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+// ReleaseWorkspaceResponse reports what releasing a workspace did
+type ReleaseWorkspaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// References counts the remaining holders of the workspace
+	References int32 `protobuf:"varint,1,opt,name=references,proto3" json:"references,omitempty"`
+	// Removed is true if the workspace directory was removed
+	Removed bool `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *ReleaseWorkspaceResponse) Reset() {
+	*x = ReleaseWorkspaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseWorkspaceResponse) ProtoMessage() {}
+
+func (x *ReleaseWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReleaseWorkspaceResponse) GetReferences() int32 {
+	if x != nil {
+		return x.References
+	}
+	return 0
+}
+
+func (x *ReleaseWorkspaceResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// ResolveResponse is what an ExecuteRequest would run. Secret references
+// are not fetched, and process-specific values are left as ${NECRO_PROCESS_ID}
+type ResolveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Tool is the requested tool or, for a shell_command, the shell
+	Tool string `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	// ToolPath is the executable the tool resolves to, empty if it is not
+	// found
+	ToolPath string `protobuf:"bytes,2,opt,name=tool_path,json=toolPath,proto3" json:"tool_path,omitempty"`
+	// Args are the arguments after tool defaults and template expansion
+	Args []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// WorkDir is the working directory, after workspace_name is applied.
+	// CreatesWorkDir is set when create_work_dir would create it
+	WorkDir        string `protobuf:"bytes,4,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
+	CreatesWorkDir bool   `protobuf:"varint,5,opt,name=creates_work_dir,json=createsWorkDir,proto3" json:"creates_work_dir,omitempty"`
+	// Env is the merged environment, redacted as in an EnvSnapshotEvent. The
+	// isolate_home variables are left out
+	Env []*EnvVar `protobuf:"bytes,6,rep,name=env,proto3" json:"env,omitempty"`
+	// TimeoutSeconds is the applied timeout and MaxLifetimeSeconds the cap on
+	// the run time (0 = none)
+	TimeoutSeconds     int32 `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	MaxLifetimeSeconds int32 `protobuf:"varint,8,opt,name=max_lifetime_seconds,json=maxLifetimeSeconds,proto3" json:"max_lifetime_seconds,omitempty"`
+	// ConcurrencyWeight is how many of max_concurrent slots the execution
+	// takes (see executor.tool_weights)
+	ConcurrencyWeight int32 `protobuf:"varint,9,opt,name=concurrency_weight,json=concurrencyWeight,proto3" json:"concurrency_weight,omitempty"`
+	// Output limits: bytes kept per stream in the response, and lines kept
+	// per second with the policy for excess lines (0 = unlimited)
+	MaxOutputBytes       int64  `protobuf:"varint,10,opt,name=max_output_bytes,json=maxOutputBytes,proto3" json:"max_output_bytes,omitempty"`
+	MaxOutputLinesPerSec int32  `protobuf:"varint,11,opt,name=max_output_lines_per_sec,json=maxOutputLinesPerSec,proto3" json:"max_output_lines_per_sec,omitempty"`
+	OutputRatePolicy     string `protobuf:"bytes,12,opt,name=output_rate_policy,json=outputRatePolicy,proto3" json:"output_rate_policy,omitempty"`
+	// FastPath is set when the output would bypass line scanning in Execute
+	FastPath bool `protobuf:"varint,13,opt,name=fast_path,json=fastPath,proto3" json:"fast_path,omitempty"`
+	// SecretRefs are the ${secret:<ref>} references in args and env
+	SecretRefs []string `protobuf:"bytes,14,rep,name=secret_refs,json=secretRefs,proto3" json:"secret_refs,omitempty"`
+	// OutputFilters are the filters applied to output lines
+	OutputFilters []string `protobuf:"bytes,15,rep,name=output_filters,json=outputFilters,proto3" json:"output_filters,omitempty"`
+	// ToolVersion is the verified version of a tool pinned in
+	// executor.tool_versions, "unknown" if it was not checked yet, as the
+	// preview never runs the version check. Empty for tools not pinned
+	ToolVersion string `protobuf:"bytes,16,opt,name=tool_version,json=toolVersion,proto3" json:"tool_version,omitempty"`
+}
+
+func (x *ResolveResponse) Reset() {
+	*x = ResolveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveResponse) ProtoMessage() {}
+
+func (x *ResolveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveResponse.ProtoReflect.Descriptor instead.
+func (*ResolveResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ResolveResponse) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *ResolveResponse) GetToolPath() string {
+	if x != nil {
+		return x.ToolPath
+	}
+	return ""
+}
+
+func (x *ResolveResponse) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ResolveResponse) GetWorkDir() string {
+	if x != nil {
+		return x.WorkDir
+	}
+	return ""
+}
+
+func (x *ResolveResponse) GetCreatesWorkDir() bool {
+	if x != nil {
+		return x.CreatesWorkDir
+	}
+	return false
+}
+
+func (x *ResolveResponse) GetEnv() []*EnvVar {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *ResolveResponse) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ResolveResponse) GetMaxLifetimeSeconds() int32 {
+	if x != nil {
+		return x.MaxLifetimeSeconds
+	}
+	return 0
+}
+
+func (x *ResolveResponse) GetConcurrencyWeight() int32 {
+	if x != nil {
+		return x.ConcurrencyWeight
+	}
+	return 0
+}
+
+func (x *ResolveResponse) GetMaxOutputBytes() int64 {
+	if x != nil {
+		return x.MaxOutputBytes
+	}
+	return 0
+}
+
+func (x *ResolveResponse) GetMaxOutputLinesPerSec() int32 {
+	if x != nil {
+		return x.MaxOutputLinesPerSec
+	}
+	return 0
+}
+
+func (x *ResolveResponse) GetOutputRatePolicy() string {
+	if x != nil {
+		return x.OutputRatePolicy
+	}
+	return ""
+}
+
+func (x *ResolveResponse) GetFastPath() bool {
+	if x != nil {
+		return x.FastPath
+	}
+	return false
+}
+
+func (x *ResolveResponse) GetSecretRefs() []string {
+	if x != nil {
+		return x.SecretRefs
+	}
+	return nil
+}
+
+func (x *ResolveResponse) GetOutputFilters() []string {
+	if x != nil {
+		return x.OutputFilters
+	}
+	return nil
+}
+
+func (x *ResolveResponse) GetToolVersion() string {
+	if x != nil {
+		return x.ToolVersion
+	}
+	return ""
+}
+
+// ReplayPipelineRequest names the pipeline whose events are replayed
+type ReplayPipelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PipelineId string `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+}
+
+func (x *ReplayPipelineRequest) Reset() {
+	*x = ReplayPipelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayPipelineRequest) ProtoMessage() {}
+
+func (x *ReplayPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayPipelineRequest.ProtoReflect.Descriptor instead.
+func (*ReplayPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReplayPipelineRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+// GetResultRequest identifies a detached execution
+type GetResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessId string `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+}
+
+func (x *GetResultRequest) Reset() {
+	*x = GetResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResultRequest) ProtoMessage() {}
+
+func (x *GetResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResultRequest.ProtoReflect.Descriptor instead.
+func (*GetResultRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetResultRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+// GetResultResponse holds a detached execution's state and, once
+// completed, its result
+type GetResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State  ResultState      `protobuf:"varint,1,opt,name=state,proto3,enum=executor.v1.ResultState" json:"state,omitempty"`
+	Result *ExecuteResponse `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetResultResponse) Reset() {
+	*x = GetResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResultResponse) ProtoMessage() {}
+
+func (x *GetResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResultResponse.ProtoReflect.Descriptor instead.
+func (*GetResultResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetResultResponse) GetState() ResultState {
+	if x != nil {
+		return x.State
+	}
+	return ResultState_RESULT_STATE_UNSPECIFIED
+}
+
+func (x *GetResultResponse) GetResult() *ExecuteResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// TailProcessRequest identifies the process to tail
+type TailProcessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessId string `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	// SkipBacklog starts with live output instead of replaying the recent
+	// lines kept by the server (see executor.tail_backlog_lines)
+	SkipBacklog bool `protobuf:"varint,2,opt,name=skip_backlog,json=skipBacklog,proto3" json:"skip_backlog,omitempty"`
+}
+
+func (x *TailProcessRequest) Reset() {
+	*x = TailProcessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TailProcessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailProcessRequest) ProtoMessage() {}
+
+func (x *TailProcessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailProcessRequest.ProtoReflect.Descriptor instead.
+func (*TailProcessRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TailProcessRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *TailProcessRequest) GetSkipBacklog() bool {
+	if x != nil {
+		return x.SkipBacklog
+	}
+	return false
+}
+
+// TailProcessResponse is one event of a tailed process
+type TailProcessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*TailProcessResponse_StdoutLine
+	//	*TailProcessResponse_StderrLine
+	//	*TailProcessResponse_Dropped
+	//	*TailProcessResponse_Result
+	Event isTailProcessResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *TailProcessResponse) Reset() {
+	*x = TailProcessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TailProcessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailProcessResponse) ProtoMessage() {}
+
+func (x *TailProcessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailProcessResponse.ProtoReflect.Descriptor instead.
+func (*TailProcessResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{53}
+}
+
+func (m *TailProcessResponse) GetEvent() isTailProcessResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *TailProcessResponse) GetStdoutLine() string {
+	if x, ok := x.GetEvent().(*TailProcessResponse_StdoutLine); ok {
+		return x.StdoutLine
+	}
+	return ""
+}
+
+func (x *TailProcessResponse) GetStderrLine() string {
+	if x, ok := x.GetEvent().(*TailProcessResponse_StderrLine); ok {
+		return x.StderrLine
+	}
+	return ""
+}
+
+func (x *TailProcessResponse) GetDropped() *DroppedEvent {
+	if x, ok := x.GetEvent().(*TailProcessResponse_Dropped); ok {
+		return x.Dropped
+	}
+	return nil
+}
+
+func (x *TailProcessResponse) GetResult() *ExecuteResponse {
+	if x, ok := x.GetEvent().(*TailProcessResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isTailProcessResponse_Event interface {
+	isTailProcessResponse_Event()
+}
+
+type TailProcessResponse_StdoutLine struct {
+	StdoutLine string `protobuf:"bytes,1,opt,name=stdout_line,json=stdoutLine,proto3,oneof"`
+}
+
+type TailProcessResponse_StderrLine struct {
+	StderrLine string `protobuf:"bytes,2,opt,name=stderr_line,json=stderrLine,proto3,oneof"`
+}
+
+type TailProcessResponse_Dropped struct {
+	// Dropped reports lines skipped because this subscriber fell behind
+	Dropped *DroppedEvent `protobuf:"bytes,3,opt,name=dropped,proto3,oneof"`
+}
+
+type TailProcessResponse_Result struct {
+	// Result is the last event, sent when the process exits
+	Result *ExecuteResponse `protobuf:"bytes,4,opt,name=result,proto3,oneof"`
+}
+
+func (*TailProcessResponse_StdoutLine) isTailProcessResponse_Event() {}
+
+func (*TailProcessResponse_StderrLine) isTailProcessResponse_Event() {}
+
+func (*TailProcessResponse_Dropped) isTailProcessResponse_Event() {}
+
+func (*TailProcessResponse_Result) isTailProcessResponse_Event() {}
+
+// DroppedEvent counts output lines a slow subscriber missed
+type DroppedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lines int64 `protobuf:"varint,1,opt,name=lines,proto3" json:"lines,omitempty"`
+}
+
+func (x *DroppedEvent) Reset() {
+	*x = DroppedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DroppedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DroppedEvent) ProtoMessage() {}
+
+func (x *DroppedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DroppedEvent.ProtoReflect.Descriptor instead.
+func (*DroppedEvent) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DroppedEvent) GetLines() int64 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+// StreamLogsRequest selects the log entries to stream
+type StreamLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MinLevel is the lowest level streamed: debug (default), info, warn or
+	// error
+	MinLevel string `protobuf:"bytes,1,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+}
+
+func (x *StreamLogsRequest) Reset() {
+	*x = StreamLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsRequest) ProtoMessage() {}
+
+func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *StreamLogsRequest) GetMinLevel() string {
+	if x != nil {
+		return x.MinLevel
+	}
+	return ""
+}
+
+// LogEntry is one server log entry
+type LogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Level   string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Logger  string                 `protobuf:"bytes,3,opt,name=logger,proto3" json:"logger,omitempty"`
+	Message string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Json is the entry with all its fields, as written to the server log
+	Json string `protobuf:"bytes,5,opt,name=json,proto3" json:"json,omitempty"`
+	// Dropped counts entries skipped before this one because the client fell
+	// behind
+	Dropped int64 `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LogEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *LogEntry) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogEntry) GetLogger() string {
+	if x != nil {
+		return x.Logger
+	}
+	return ""
+}
+
+func (x *LogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogEntry) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+func (x *LogEntry) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+// ListToolsRequest requests the allowed tools
+type ListToolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListToolsRequest) Reset() {
+	*x = ListToolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListToolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolsRequest) ProtoMessage() {}
+
+func (x *ListToolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolsRequest.ProtoReflect.Descriptor instead.
+func (*ListToolsRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{57}
+}
+
+// ToolInfo describes an allowed_tools entry
+type ToolInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is the allowed_tools entry
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Pattern is true for re: and glob: entries, which match many tools
+	Pattern bool `protobuf:"varint,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// ResolvedPath is where the tool was found on PATH (empty for patterns
+	// and tools that are not installed)
+	ResolvedPath string `protobuf:"bytes,3,opt,name=resolved_path,json=resolvedPath,proto3" json:"resolved_path,omitempty"`
+	// Version is the detected version of a pinned tool (see tool_versions)
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// VersionPattern is the version a pinned tool must match
+	VersionPattern string `protobuf:"bytes,5,opt,name=version_pattern,json=versionPattern,proto3" json:"version_pattern,omitempty"`
+	// VersionError is why the pinned version check failed
+	VersionError string `protobuf:"bytes,6,opt,name=version_error,json=versionError,proto3" json:"version_error,omitempty"`
+	// DefaultTimeoutSeconds applies when a request sets no timeout
+	DefaultTimeoutSeconds int32 `protobuf:"varint,7,opt,name=default_timeout_seconds,json=defaultTimeoutSeconds,proto3" json:"default_timeout_seconds,omitempty"`
+	// ArgsLogged is false when the tool's arguments are kept out of logs
+	ArgsLogged bool `protobuf:"varint,8,opt,name=args_logged,json=argsLogged,proto3" json:"args_logged,omitempty"`
+}
+
+func (x *ToolInfo) Reset() {
+	*x = ToolInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToolInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolInfo) ProtoMessage() {}
+
+func (x *ToolInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolInfo.ProtoReflect.Descriptor instead.
+func (*ToolInfo) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ToolInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolInfo) GetPattern() bool {
+	if x != nil {
+		return x.Pattern
+	}
+	return false
+}
+
+func (x *ToolInfo) GetResolvedPath() string {
+	if x != nil {
+		return x.ResolvedPath
+	}
+	return ""
+}
+
+func (x *ToolInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ToolInfo) GetVersionPattern() string {
+	if x != nil {
+		return x.VersionPattern
+	}
+	return ""
+}
+
+func (x *ToolInfo) GetVersionError() string {
+	if x != nil {
+		return x.VersionError
+	}
+	return ""
+}
+
+func (x *ToolInfo) GetDefaultTimeoutSeconds() int32 {
+	if x != nil {
+		return x.DefaultTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ToolInfo) GetArgsLogged() bool {
+	if x != nil {
+		return x.ArgsLogged
+	}
+	return false
+}
+
+// ListToolsResponse contains the allowed tools
+type ListToolsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tools []*ToolInfo `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"`
+}
+
+func (x *ListToolsResponse) Reset() {
+	*x = ListToolsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListToolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolsResponse) ProtoMessage() {}
+
+func (x *ListToolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolsResponse.ProtoReflect.Descriptor instead.
+func (*ListToolsResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListToolsResponse) GetTools() []*ToolInfo {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+// DownloadFileRequest names the file to download
+type DownloadFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path is the file, relative to the workspace base or absolute. It must
+	// lie inside the workspace base, or the caller's tenant directory with
+	// tenant isolation
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *DownloadFileRequest) Reset() {
+	*x = DownloadFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileRequest) ProtoMessage() {}
+
+func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileRequest.ProtoReflect.Descriptor instead.
+func (*DownloadFileRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DownloadFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// FileChunk is a consecutive piece of a downloaded file
+type FileChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Offset is where data starts in the file
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Size is the file's total size, set on the first chunk
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Sha256 is the hex digest of the whole file, set on the last chunk
+	Sha256 string `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *FileChunk) Reset() {
+	*x = FileChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileChunk) ProtoMessage() {}
+
+func (x *FileChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileChunk.ProtoReflect.Descriptor instead.
+func (*FileChunk) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FileChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FileChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FileChunk) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileChunk) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// CheckToolRequest names the tool to probe
+type CheckToolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tool string `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+}
+
+func (x *CheckToolRequest) Reset() {
+	*x = CheckToolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckToolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckToolRequest) ProtoMessage() {}
+
+func (x *CheckToolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckToolRequest.ProtoReflect.Descriptor instead.
+func (*CheckToolRequest) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CheckToolRequest) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+// CheckToolResponse is the result of a tool health probe
+type CheckToolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tool   string `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	Passed bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// Output is the probe's combined stdout and stderr, cut at 4KiB
+	Output string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	// Error is why the probe failed
+	Error      string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	CheckedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+}
+
+func (x *CheckToolResponse) Reset() {
+	*x = CheckToolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckToolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckToolResponse) ProtoMessage() {}
+
+func (x *CheckToolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckToolResponse.ProtoReflect.Descriptor instead.
+func (*CheckToolResponse) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CheckToolResponse) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *CheckToolResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *CheckToolResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *CheckToolResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CheckToolResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *CheckToolResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// TerminalInput is a message from the client of a terminal session. The
+// first must be start, the rest data or resize
+type TerminalInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Input:
+	//
+	//	*TerminalInput_Start
+	//	*TerminalInput_Data
+	//	*TerminalInput_Resize
+	Input isTerminalInput_Input `protobuf_oneof:"input"`
+}
+
+func (x *TerminalInput) Reset() {
+	*x = TerminalInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalInput) ProtoMessage() {}
+
+func (x *TerminalInput) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalInput.ProtoReflect.Descriptor instead.
+func (*TerminalInput) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{64}
+}
+
+func (m *TerminalInput) GetInput() isTerminalInput_Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (x *TerminalInput) GetStart() *TerminalStart {
+	if x, ok := x.GetInput().(*TerminalInput_Start); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TerminalInput) GetData() []byte {
+	if x, ok := x.GetInput().(*TerminalInput_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TerminalInput) GetResize() *TerminalSize {
+	if x, ok := x.GetInput().(*TerminalInput_Resize); ok {
+		return x.Resize
+	}
+	return nil
+}
+
+type isTerminalInput_Input interface {
+	isTerminalInput_Input()
+}
+
+type TerminalInput_Start struct {
+	Start *TerminalStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type TerminalInput_Data struct {
+	// Data is written to the terminal as typed, e.g. "\x03" for Ctrl-C
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+type TerminalInput_Resize struct {
+	Resize *TerminalSize `protobuf:"bytes,3,opt,name=resize,proto3,oneof"`
+}
+
+func (*TerminalInput_Start) isTerminalInput_Input() {}
+
+func (*TerminalInput_Data) isTerminalInput_Input() {}
+
+func (*TerminalInput_Resize) isTerminalInput_Input() {}
+
+// TerminalStart starts the command of a terminal session
+type TerminalStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Request is the command to run. Output options such as capture, masking
+	// and filters do not apply: the terminal's output is streamed as is
+	Request *ExecuteRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// Size is the initial window size, 24x80 if unset
+	Size *TerminalSize `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Term is the TERM of the command's environment, xterm-256color if empty
+	Term string `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+}
+
+func (x *TerminalStart) Reset() {
+	*x = TerminalStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalStart) ProtoMessage() {}
+
+func (x *TerminalStart) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalStart.ProtoReflect.Descriptor instead.
+func (*TerminalStart) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TerminalStart) GetRequest() *ExecuteRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *TerminalStart) GetSize() *TerminalSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *TerminalStart) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+// TerminalSize is a terminal window size in character cells
+type TerminalSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows uint32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols uint32 `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
+}
+
+func (x *TerminalSize) Reset() {
+	*x = TerminalSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalSize) ProtoMessage() {}
+
+func (x *TerminalSize) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalSize.ProtoReflect.Descriptor instead.
+func (*TerminalSize) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *TerminalSize) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *TerminalSize) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+// TerminalOutput is a message to the client of a terminal session
+type TerminalOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Output:
+	//
+	//	*TerminalOutput_Started
+	//	*TerminalOutput_Data
+	//	*TerminalOutput_Exited
+	Output isTerminalOutput_Output `protobuf_oneof:"output"`
+}
+
+func (x *TerminalOutput) Reset() {
+	*x = TerminalOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalOutput) ProtoMessage() {}
+
+func (x *TerminalOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalOutput.ProtoReflect.Descriptor instead.
+func (*TerminalOutput) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{67}
+}
+
+func (m *TerminalOutput) GetOutput() isTerminalOutput_Output {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (x *TerminalOutput) GetStarted() *TerminalStarted {
+	if x, ok := x.GetOutput().(*TerminalOutput_Started); ok {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *TerminalOutput) GetData() []byte {
+	if x, ok := x.GetOutput().(*TerminalOutput_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TerminalOutput) GetExited() *TerminalExited {
+	if x, ok := x.GetOutput().(*TerminalOutput_Exited); ok {
+		return x.Exited
+	}
+	return nil
+}
+
+type isTerminalOutput_Output interface {
+	isTerminalOutput_Output()
+}
+
+type TerminalOutput_Started struct {
+	// Started is sent once the command runs
+	Started *TerminalStarted `protobuf:"bytes,1,opt,name=started,proto3,oneof"`
+}
+
+type TerminalOutput_Data struct {
+	// Data is terminal output, stdout and stderr interleaved as displayed
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+type TerminalOutput_Exited struct {
+	// Exited is the last message
+	Exited *TerminalExited `protobuf:"bytes,3,opt,name=exited,proto3,oneof"`
+}
+
+func (*TerminalOutput_Started) isTerminalOutput_Output() {}
+
+func (*TerminalOutput_Data) isTerminalOutput_Output() {}
+
+func (*TerminalOutput_Exited) isTerminalOutput_Output() {}
+
+// TerminalStarted identifies the process of a terminal session
+type TerminalStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessId string `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	OsPid     int32  `protobuf:"varint,2,opt,name=os_pid,json=osPid,proto3" json:"os_pid,omitempty"`
+}
+
+func (x *TerminalStarted) Reset() {
+	*x = TerminalStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalStarted) ProtoMessage() {}
+
+func (x *TerminalStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalStarted.ProtoReflect.Descriptor instead.
+func (*TerminalStarted) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TerminalStarted) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *TerminalStarted) GetOsPid() int32 {
+	if x != nil {
+		return x.OsPid
+	}
+	return 0
+}
+
+// TerminalExited is how the command of a terminal session ended
+type TerminalExited struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExitCode   int32  `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Success    bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error      string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	TimedOut   bool   `protobuf:"varint,4,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	DurationMs int64  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+}
+
+func (x *TerminalExited) Reset() {
+	*x = TerminalExited{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_v1_executor_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalExited) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalExited) ProtoMessage() {}
+
+func (x *TerminalExited) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_v1_executor_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalExited.ProtoReflect.Descriptor instead.
+func (*TerminalExited) Descriptor() ([]byte, []int) {
+	return file_executor_v1_executor_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TerminalExited) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *TerminalExited) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TerminalExited) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TerminalExited) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *TerminalExited) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+var File_executor_v1_executor_proto protoreflect.FileDescriptor
+
+var file_executor_v1_executor_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x0a, 0x0a, 0x0e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65,
+	0x6e, 0x76, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x44, 0x69, 0x72, 0x12, 0x45, 0x0a, 0x10, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x5f, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x44, 0x69, 0x72, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x0e, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1e, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x61, 0x72, 0x67,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x41, 0x72,
+	0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x73, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x65, 0x6c, 0x6c,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x65, 0x6c, 0x6c,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x65,
+	0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x69, 0x6f, 0x5f, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0a,
+	0x69, 0x6f, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69,
+	0x6e, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x78,
+	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x05, 0x52, 0x11,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0d,
+	0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x64, 0x65,
+	0x72, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0d, 0x63, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x65, 0x6d, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6d, 0x69, 0x74,
+	0x45, 0x6e, 0x76, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x68,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x73,
+	0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73,
+	0x68, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x5f,
+	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x4f, 0x6e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d,
+	0x61, 0x78, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x12, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x6f,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6a, 0x6f, 0x62,
+	0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x61, 0x72, 0x67,
+	0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x69, 0x6f, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x64, 0x6f, 0x75,
+	0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x74,
+	0x64, 0x65, 0x72, 0x72, 0x22, 0xec, 0x07, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69,
+	0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78,
+	0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x6f, 0x73, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x73,
+	0x50, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x5f, 0x68, 0x69,
+	0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x48, 0x69, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x64,
+	0x65, 0x72, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x64, 0x6f,
+	0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x74, 0x64, 0x6f, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x64,
+	0x65, 0x72, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x4d, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x72, 0x75, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72,
+	0x75, 0x6e, 0x4d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x61, 0x73, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x53,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x48, 0x61, 0x73, 0x68, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x5f, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x4f, 0x6e, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x22, 0xe1, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x3b,
+	0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x65, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x65, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74,
+	0x65, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x4e, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xbe, 0x05, 0x0a,
+	0x15, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74,
+	0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73,
+	0x74, 0x64, 0x6f, 0x75, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x74, 0x64,
+	0x65, 0x72, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x36, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x38, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x35, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x12, 0x42, 0x0a, 0x0c, 0x65, 0x6e, 0x76, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x76, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0b, 0x73,
+	0x74, 0x64, 0x6f, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74,
+	0x64, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x42, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x96, 0x01,
+	0x0a, 0x10, 0x45, 0x6e, 0x76, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x76, 0x56, 0x61, 0x72, 0x52, 0x04, 0x76, 0x61, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
+	0x65, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x74, 0x65, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x65,
+	0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x4c, 0x0a, 0x06, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x61,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x64, 0x61,
+	0x63, 0x74, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4d,
+	0x0a, 0x0d, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x22, 0xd8, 0x01,
+	0x0a, 0x0c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x4c, 0x69, 0x6e, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x4c,
+	0x69, 0x6e, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x64, 0x6f,
+	0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x64, 0x65, 0x72,
+	0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73,
+	0x74, 0x64, 0x65, 0x72, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78,
+	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65,
+	0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xae, 0x0e, 0x0a, 0x09, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f,
+	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12,
+	0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
+	0x69, 0x6e, 0x75, 0x65, 0x4f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x24, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x53,
+	0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x1e, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x61, 0x72, 0x67,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x41, 0x72,
+	0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x72, 0x65, 0x74, 0x72, 0x79, 0x42, 0x61, 0x63, 0x6b,
+	0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x5f, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e,
+	0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x46, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x65,
+	0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x65,
+	0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x68, 0x65, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x01, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x0b, 0x69, 0x6f, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0a, 0x69, 0x6f, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x5f,
+	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x64,
+	0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x61, 0x69, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x05, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x2a, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x64, 0x6f, 0x75,
+	0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0d, 0x63, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x63,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0d, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74,
+	0x64, 0x65, 0x72, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x6f, 0x6c, 0x61,
+	0x74, 0x65, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
+	0x73, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x6d,
+	0x69, 0x74, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6d, 0x69, 0x74, 0x45, 0x6e, 0x76, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x73, 0x65, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x23,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72,
+	0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x4f,
+	0x6e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f,
+	0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x25, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x66, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x64,
+	0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x26, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x14,
+	0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x73, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x41, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x66, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x2a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x75, 0x6e, 0x49, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x74,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f,
+	0x67, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x69, 0x6f, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x22, 0x5b, 0x0a, 0x0e, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xe7, 0x01, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x51, 0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x68, 0x65, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xf0, 0x02, 0x0a, 0x0f, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x64,
+	0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x44, 0x69, 0x72, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x24, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x53,
+	0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x47, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa2, 0x04, 0x0a,
+	0x0a, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x43,
+	0x0a, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29,
+	0x0a, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x77,
+	0x61, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x75, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x4d, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x4d, 0x73, 0x12,
+	0x43, 0x0a, 0x0e, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0d, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0xc4, 0x06, 0x0a, 0x10, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x65, 0x70,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x4d, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x72, 0x75, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72,
+	0x75, 0x6e, 0x4d, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x38, 0x0a,
+	0x0b, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x74, 0x6f,
+	0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x0e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x44, 0x0a,
+	0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64,
+	0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x65, 0x70, 0x73, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6b, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x30, 0x0a, 0x05, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c,
+	0x65, 0x6c, 0x69, 0x73, 0x6d, 0x22, 0x8e, 0x02, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xf3, 0x05, 0x0a, 0x16, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x0c, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x65, 0x70,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65,
+	0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x65, 0x70, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x12, 0x70, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x11, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a,
+	0x0c, 0x65, 0x6e, 0x76, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x76, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x4e, 0x0a, 0x10, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x12,
+	0x42, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a,
+	0x0e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0xb4, 0x01, 0x0a, 0x14, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4d, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x53, 0x74, 0x65, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xc8, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x20, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x6f, 0x5f, 0x66, 0x61, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x6f, 0x46, 0x61,
+	0x72, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x65,
+	0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
+	0x65, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xc9, 0x01, 0x0a, 0x10, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x64, 0x65, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65,
+	0x72, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0xaa, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74,
+	0x65, 0x70, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xfd,
+	0x01, 0x0a, 0x0f, 0x53, 0x74, 0x65, 0x70, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21,
+	0x0a, 0x0b, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x4c, 0x69, 0x6e,
+	0x65, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72,
+	0x4c, 0x69, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74,
+	0x64, 0x6f, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x64,
+	0x65, 0x72, 0x72, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x0b, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x68, 0x61, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x6c,
+	0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x61, 0x69, 0x74, 0x4d, 0x73, 0x22, 0x74, 0x0a, 0x0e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x22, 0x4e, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x79, 0x54, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x22, 0x37, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x79, 0x54, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x15, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x22,
+	0x8d, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f,
+	0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x73, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6f, 0x73, 0x50, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x64,
+	0x6f, 0x75, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0x64, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa3, 0x04, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x6f,
+	0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x68, 0x72,
+	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74,
+	0x6c, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x31, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f,
+	0x70, 0x65, 0x6e, 0x46, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0xd2, 0x02, 0x0a, 0x0c,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c,
+	0x69, 0x6e, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6d, 0x61, 0x78,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x6d, 0x61, 0x78, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x73,
+	0x22, 0x48, 0x0a, 0x17, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x18, 0x41,
+	0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64,
+	0x12, 0x44, 0x0a, 0x10, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x48, 0x0a, 0x17, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64,
+	0x22, 0x54, 0x0a, 0x18, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0xe4, 0x04, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x6f, 0x6f, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x57, 0x6f, 0x72,
+	0x6b, 0x44, 0x69, 0x72, 0x12, 0x25, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x27, 0x0a, 0x0f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x36, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4c, 0x69, 0x6e, 0x65,
+	0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x73, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x65, 0x66, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x6f, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a,
+	0x15, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x34, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x56, 0x0a, 0x12, 0x54, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6b,
+	0x69, 0x70, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x22, 0xd3, 0x01,
+	0x0a, 0x13, 0x54, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74,
+	0x64, 0x6f, 0x75, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x74, 0x64, 0x65,
+	0x72, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0a, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x64,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x22, 0x24, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x11, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xb0, 0x01, 0x0a, 0x08,
+	0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x12,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x08, 0x54, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x67, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x67, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x72, 0x67, 0x73, 0x4c, 0x6f, 0x67,
+	0x67, 0x65, 0x64, 0x22, 0x40, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6f, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x22, 0x63, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x26, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x22, 0xc9, 0x01,
+	0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x14, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65,
+	0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x0d, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x22,
+	0x36, 0x0a, 0x0c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0e, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x06, 0x65, 0x78,
+	0x69, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x45, 0x78, 0x69, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x65, 0x78, 0x69, 0x74, 0x65,
+	0x64, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x47, 0x0a, 0x0f, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x15, 0x0a,
+	0x06, 0x6f, 0x73, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f,
+	0x73, 0x50, 0x69, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x0e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x45, 0x78, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x2a, 0x91, 0x01, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x43, 0x6c,
+	0x65, 0x61, 0x6e, 0x75, 0x70, 0x12, 0x20, 0x0a, 0x1c, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x44, 0x49,
+	0x52, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x4f, 0x52, 0x4b, 0x5f,
+	0x44, 0x49, 0x52, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x5f, 0x4f, 0x4e, 0x5f, 0x53,
+	0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x4f, 0x52, 0x4b,
+	0x5f, 0x44, 0x49, 0x52, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x5f, 0x4f, 0x4e, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x4f, 0x52,
+	0x4b, 0x5f, 0x44, 0x49, 0x52, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x5f, 0x41, 0x4c,
+	0x57, 0x41, 0x59, 0x53, 0x10, 0x03, 0x2a, 0x60, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x4e, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x52, 0x41, 0x57, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x53, 0x10, 0x01, 0x12, 0x18,
+	0x0a, 0x14, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f,
+	0x4d, 0x42, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xcd, 0x02, 0x0a, 0x09, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x53, 0x54, 0x44, 0x45, 0x52, 0x52, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x01,
+	0x12, 0x21, 0x0a, 0x1d, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45,
+	0x58, 0x50, 0x45, 0x43, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x03,
+	0x12, 0x22, 0x0a, 0x1e, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53,
+	0x54, 0x45, 0x50, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45,
+	0x52, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53,
+	0x55, 0x4d, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x06, 0x12, 0x1b, 0x0a,
+	0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x5f,
+	0x4c, 0x49, 0x46, 0x45, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x08, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x09, 0x2a, 0xcf, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x6f,
+	0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x4f, 0x50, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x1b, 0x0a, 0x17, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x45, 0x50, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13,
+	0x53, 0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x4f, 0x55, 0x54, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18,
+	0x53, 0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x45, 0x50,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x06, 0x2a, 0xc6, 0x01, 0x0a, 0x09, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11,
+	0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52,
+	0x59, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x43, 0x50, 0x55, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f,
+	0x51, 0x55, 0x4f, 0x54, 0x41, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x52, 0x41, 0x54,
+	0x45, 0x10, 0x06, 0x2a, 0x74, 0x0a, 0x10, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x45, 0x53, 0x54, 0x5f,
+	0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54,
+	0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x4a, 0x55, 0x4e, 0x49, 0x54, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x45, 0x53,
+	0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x47, 0x4f, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x8d, 0x01, 0x0a, 0x0e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c,
+	0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c,
+	0x0a, 0x18, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a,
+	0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17,
+	0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xa0, 0x01, 0x0a, 0x0b, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x1a, 0x0a, 0x16, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x61, 0x0a, 0x0b,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x52,
+	0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x53,
+	0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x32,
+	0x8b, 0x0e, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x1b,
+	0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x4e, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
+	0x12, 0x48, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x22, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
+	0x79, 0x54, 0x6f, 0x6f, 0x6c, 0x12, 0x20, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x79, 0x54, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x79, 0x54, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x20, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x1a, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x50, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f,
+	0x6f, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x0b, 0x54, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x1f, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x69, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0c, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x09, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x54, 0x6f, 0x6f, 0x6c, 0x12, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x22, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
+	0x12, 0x4b, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
+	0x12, 0x1a, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x28, 0x01, 0x30, 0x01, 0x42, 0x40, 0x5a,
+	0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x6e, 0x75, 0x6c,
+	0x6c, 0x63, 0x69, 0x2f, 0x6e, 0x65, 0x63, 0x72, 0x6f, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_executor_v1_executor_proto_rawDescOnce sync.Once
+	file_executor_v1_executor_proto_rawDescData = file_executor_v1_executor_proto_rawDesc
+)
+
+func file_executor_v1_executor_proto_rawDescGZIP() []byte {
+	file_executor_v1_executor_proto_rawDescOnce.Do(func() {
+		file_executor_v1_executor_proto_rawDescData = protoimpl.X.CompressGZIP(file_executor_v1_executor_proto_rawDescData)
+	})
+	return file_executor_v1_executor_proto_rawDescData
+}
+
+var file_executor_v1_executor_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_executor_v1_executor_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
+var file_executor_v1_executor_proto_goTypes = []interface{}{
+	(WorkDirCleanup)(0),              // 0: executor.v1.WorkDirCleanup
+	(OutputMode)(0),                  // 1: executor.v1.OutputMode
+	(ErrorCode)(0),                   // 2: executor.v1.ErrorCode
+	(StopReason)(0),                  // 3: executor.v1.StopReason
+	(LimitKind)(0),                   // 4: executor.v1.LimitKind
+	(TestReportFormat)(0),            // 5: executor.v1.TestReportFormat
+	(ImagePullState)(0),              // 6: executor.v1.ImagePullState
+	(CancelState)(0),                 // 7: executor.v1.CancelState
+	(ResultState)(0),                 // 8: executor.v1.ResultState
+	(*ExecuteRequest)(nil),           // 9: executor.v1.ExecuteRequest
+	(*ExecuteResponse)(nil),          // 10: executor.v1.ExecuteResponse
+	(*LimitEvent)(nil),               // 11: executor.v1.LimitEvent
+	(*ExecuteBatchRequest)(nil),      // 12: executor.v1.ExecuteBatchRequest
+	(*BatchResult)(nil),              // 13: executor.v1.BatchResult
+	(*ExecuteBatchResponse)(nil),     // 14: executor.v1.ExecuteBatchResponse
+	(*ExecuteStreamResponse)(nil),    // 15: executor.v1.ExecuteStreamResponse
+	(*EnvSnapshotEvent)(nil),         // 16: executor.v1.EnvSnapshotEvent
+	(*EnvVar)(nil),                   // 17: executor.v1.EnvVar
+	(*StartedEvent)(nil),             // 18: executor.v1.StartedEvent
+	(*DetachedEvent)(nil),            // 19: executor.v1.DetachedEvent
+	(*SummaryEvent)(nil),             // 20: executor.v1.SummaryEvent
+	(*BuildStep)(nil),                // 21: executor.v1.BuildStep
+	(*TestReportSpec)(nil),           // 22: executor.v1.TestReportSpec
+	(*TestReport)(nil),               // 23: executor.v1.TestReport
+	(*TestFailure)(nil),              // 24: executor.v1.TestFailure
+	(*StepCommand)(nil),              // 25: executor.v1.StepCommand
+	(*PipelineRequest)(nil),          // 26: executor.v1.PipelineRequest
+	(*StepResult)(nil),               // 27: executor.v1.StepResult
+	(*PipelineResponse)(nil),         // 28: executor.v1.PipelineResponse
+	(*ExecutionGraph)(nil),           // 29: executor.v1.ExecutionGraph
+	(*ExecutionNode)(nil),            // 30: executor.v1.ExecutionNode
+	(*PipelineStreamResponse)(nil),   // 31: executor.v1.PipelineStreamResponse
+	(*ImagePullEvent)(nil),           // 32: executor.v1.ImagePullEvent
+	(*PipelineTimeoutEvent)(nil),     // 33: executor.v1.PipelineTimeoutEvent
+	(*DeadlineEvent)(nil),            // 34: executor.v1.DeadlineEvent
+	(*ProgressEvent)(nil),            // 35: executor.v1.ProgressEvent
+	(*RateLimitedEvent)(nil),         // 36: executor.v1.RateLimitedEvent
+	(*StepStartedEvent)(nil),         // 37: executor.v1.StepStartedEvent
+	(*StepOutputEvent)(nil),          // 38: executor.v1.StepOutputEvent
+	(*CancelRequest)(nil),            // 39: executor.v1.CancelRequest
+	(*CancelResponse)(nil),           // 40: executor.v1.CancelResponse
+	(*CancelByToolRequest)(nil),      // 41: executor.v1.CancelByToolRequest
+	(*CancelByToolResponse)(nil),     // 42: executor.v1.CancelByToolResponse
+	(*CancelPipelineRequest)(nil),    // 43: executor.v1.CancelPipelineRequest
+	(*CancelPipelineResponse)(nil),   // 44: executor.v1.CancelPipelineResponse
+	(*GetProcessesRequest)(nil),      // 45: executor.v1.GetProcessesRequest
+	(*ProcessInfo)(nil),              // 46: executor.v1.ProcessInfo
+	(*GetProcessesResponse)(nil),     // 47: executor.v1.GetProcessesResponse
+	(*PingRequest)(nil),              // 48: executor.v1.PingRequest
+	(*PingResponse)(nil),             // 49: executor.v1.PingResponse
+	(*HealthRequest)(nil),            // 50: executor.v1.HealthRequest
+	(*HealthResponse)(nil),           // 51: executor.v1.HealthResponse
+	(*ServerLimits)(nil),             // 52: executor.v1.ServerLimits
+	(*AcquireWorkspaceRequest)(nil),  // 53: executor.v1.AcquireWorkspaceRequest
+	(*AcquireWorkspaceResponse)(nil), // 54: executor.v1.AcquireWorkspaceResponse
+	(*ReleaseWorkspaceRequest)(nil),  // 55: executor.v1.ReleaseWorkspaceRequest
+	(*ReleaseWorkspaceResponse)(nil), // 56: executor.v1.ReleaseWorkspaceResponse
+	(*ResolveResponse)(nil),          // 57: executor.v1.ResolveResponse
+	(*ReplayPipelineRequest)(nil),    // 58: executor.v1.ReplayPipelineRequest
+	(*GetResultRequest)(nil),         // 59: executor.v1.GetResultRequest
+	(*GetResultResponse)(nil),        // 60: executor.v1.GetResultResponse
+	(*TailProcessRequest)(nil),       // 61: executor.v1.TailProcessRequest
+	(*TailProcessResponse)(nil),      // 62: executor.v1.TailProcessResponse
+	(*DroppedEvent)(nil),             // 63: executor.v1.DroppedEvent
+	(*StreamLogsRequest)(nil),        // 64: executor.v1.StreamLogsRequest
+	(*LogEntry)(nil),                 // 65: executor.v1.LogEntry
+	(*ListToolsRequest)(nil),         // 66: executor.v1.ListToolsRequest
+	(*ToolInfo)(nil),                 // 67: executor.v1.ToolInfo
+	(*ListToolsResponse)(nil),        // 68: executor.v1.ListToolsResponse
+	(*DownloadFileRequest)(nil),      // 69: executor.v1.DownloadFileRequest
+	(*FileChunk)(nil),                // 70: executor.v1.FileChunk
+	(*CheckToolRequest)(nil),         // 71: executor.v1.CheckToolRequest
+	(*CheckToolResponse)(nil),        // 72: executor.v1.CheckToolResponse
+	(*TerminalInput)(nil),            // 73: executor.v1.TerminalInput
+	(*TerminalStart)(nil),            // 74: executor.v1.TerminalStart
+	(*TerminalSize)(nil),             // 75: executor.v1.TerminalSize
+	(*TerminalOutput)(nil),           // 76: executor.v1.TerminalOutput
+	(*TerminalStarted)(nil),          // 77: executor.v1.TerminalStarted
+	(*TerminalExited)(nil),           // 78: executor.v1.TerminalExited
+	nil,                              // 79: executor.v1.BuildStep.VerifyFilesEntry
+	nil,                              // 80: executor.v1.PipelineResponse.OutputsEntry
+	(*timestamppb.Timestamp)(nil),    // 81: google.protobuf.Timestamp
+}
+var file_executor_v1_executor_proto_depIdxs = []int32{
+	0,   // 0: executor.v1.ExecuteRequest.cleanup_work_dir:type_name -> executor.v1.WorkDirCleanup
+	1,   // 1: executor.v1.ExecuteRequest.output_mode:type_name -> executor.v1.OutputMode
+	81,  // 2: executor.v1.ExecuteResponse.started_at:type_name -> google.protobuf.Timestamp
+	81,  // 3: executor.v1.ExecuteResponse.ended_at:type_name -> google.protobuf.Timestamp
+	11,  // 4: executor.v1.ExecuteResponse.limits_hit:type_name -> executor.v1.LimitEvent
+	2,   // 5: executor.v1.ExecuteResponse.error_code:type_name -> executor.v1.ErrorCode
+	4,   // 6: executor.v1.LimitEvent.limit:type_name -> executor.v1.LimitKind
+	81,  // 7: executor.v1.LimitEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	9,   // 8: executor.v1.ExecuteBatchRequest.requests:type_name -> executor.v1.ExecuteRequest
+	10,  // 9: executor.v1.BatchResult.result:type_name -> executor.v1.ExecuteResponse
+	13,  // 10: executor.v1.ExecuteBatchResponse.results:type_name -> executor.v1.BatchResult
+	10,  // 11: executor.v1.ExecuteStreamResponse.result:type_name -> executor.v1.ExecuteResponse
+	11,  // 12: executor.v1.ExecuteStreamResponse.limit:type_name -> executor.v1.LimitEvent
+	34,  // 13: executor.v1.ExecuteStreamResponse.deadline:type_name -> executor.v1.DeadlineEvent
+	35,  // 14: executor.v1.ExecuteStreamResponse.progress:type_name -> executor.v1.ProgressEvent
+	20,  // 15: executor.v1.ExecuteStreamResponse.summary:type_name -> executor.v1.SummaryEvent
+	18,  // 16: executor.v1.ExecuteStreamResponse.started:type_name -> executor.v1.StartedEvent
+	19,  // 17: executor.v1.ExecuteStreamResponse.detached:type_name -> executor.v1.DetachedEvent
+	16,  // 18: executor.v1.ExecuteStreamResponse.env_snapshot:type_name -> executor.v1.EnvSnapshotEvent
+	36,  // 19: executor.v1.ExecuteStreamResponse.rate_limited:type_name -> executor.v1.RateLimitedEvent
+	17,  // 20: executor.v1.EnvSnapshotEvent.vars:type_name -> executor.v1.EnvVar
+	81,  // 21: executor.v1.StartedEvent.started_at:type_name -> google.protobuf.Timestamp
+	25,  // 22: executor.v1.BuildStep.before:type_name -> executor.v1.StepCommand
+	25,  // 23: executor.v1.BuildStep.after:type_name -> executor.v1.StepCommand
+	79,  // 24: executor.v1.BuildStep.verify_files:type_name -> executor.v1.BuildStep.VerifyFilesEntry
+	1,   // 25: executor.v1.BuildStep.output_mode:type_name -> executor.v1.OutputMode
+	22,  // 26: executor.v1.BuildStep.test_report:type_name -> executor.v1.TestReportSpec
+	5,   // 27: executor.v1.TestReportSpec.format:type_name -> executor.v1.TestReportFormat
+	24,  // 28: executor.v1.TestReport.failures:type_name -> executor.v1.TestFailure
+	21,  // 29: executor.v1.PipelineRequest.steps:type_name -> executor.v1.BuildStep
+	10,  // 30: executor.v1.StepResult.execute_result:type_name -> executor.v1.ExecuteResponse
+	10,  // 31: executor.v1.StepResult.before_results:type_name -> executor.v1.ExecuteResponse
+	10,  // 32: executor.v1.StepResult.after_results:type_name -> executor.v1.ExecuteResponse
+	23,  // 33: executor.v1.StepResult.test_report:type_name -> executor.v1.TestReport
+	27,  // 34: executor.v1.PipelineResponse.step_results:type_name -> executor.v1.StepResult
+	81,  // 35: executor.v1.PipelineResponse.started_at:type_name -> google.protobuf.Timestamp
+	81,  // 36: executor.v1.PipelineResponse.ended_at:type_name -> google.protobuf.Timestamp
+	3,   // 37: executor.v1.PipelineResponse.stop_reason:type_name -> executor.v1.StopReason
+	29,  // 38: executor.v1.PipelineResponse.execution_graph:type_name -> executor.v1.ExecutionGraph
+	80,  // 39: executor.v1.PipelineResponse.outputs:type_name -> executor.v1.PipelineResponse.OutputsEntry
+	30,  // 40: executor.v1.ExecutionGraph.nodes:type_name -> executor.v1.ExecutionNode
+	81,  // 41: executor.v1.ExecutionNode.started_at:type_name -> google.protobuf.Timestamp
+	81,  // 42: executor.v1.ExecutionNode.ended_at:type_name -> google.protobuf.Timestamp
+	37,  // 43: executor.v1.PipelineStreamResponse.step_started:type_name -> executor.v1.StepStartedEvent
+	38,  // 44: executor.v1.PipelineStreamResponse.step_output:type_name -> executor.v1.StepOutputEvent
+	27,  // 45: executor.v1.PipelineStreamResponse.step_completed:type_name -> executor.v1.StepResult
+	28,  // 46: executor.v1.PipelineStreamResponse.pipeline_completed:type_name -> executor.v1.PipelineResponse
+	11,  // 47: executor.v1.PipelineStreamResponse.limit:type_name -> executor.v1.LimitEvent
+	34,  // 48: executor.v1.PipelineStreamResponse.deadline:type_name -> executor.v1.DeadlineEvent
+	35,  // 49: executor.v1.PipelineStreamResponse.progress:type_name -> executor.v1.ProgressEvent
+	16,  // 50: executor.v1.PipelineStreamResponse.env_snapshot:type_name -> executor.v1.EnvSnapshotEvent
+	33,  // 51: executor.v1.PipelineStreamResponse.pipeline_timeout:type_name -> executor.v1.PipelineTimeoutEvent
+	32,  // 52: executor.v1.PipelineStreamResponse.image_pull:type_name -> executor.v1.ImagePullEvent
+	36,  // 53: executor.v1.PipelineStreamResponse.rate_limited:type_name -> executor.v1.RateLimitedEvent
+	6,   // 54: executor.v1.ImagePullEvent.state:type_name -> executor.v1.ImagePullState
+	81,  // 55: executor.v1.DeadlineEvent.deadline:type_name -> google.protobuf.Timestamp
+	81,  // 56: executor.v1.StepStartedEvent.started_at:type_name -> google.protobuf.Timestamp
+	7,   // 57: executor.v1.CancelResponse.state:type_name -> executor.v1.CancelState
+	81,  // 58: executor.v1.ProcessInfo.started_at:type_name -> google.protobuf.Timestamp
+	46,  // 59: executor.v1.GetProcessesResponse.processes:type_name -> executor.v1.ProcessInfo
+	81,  // 60: executor.v1.PingResponse.time:type_name -> google.protobuf.Timestamp
+	81,  // 61: executor.v1.HealthResponse.checked_at:type_name -> google.protobuf.Timestamp
+	52,  // 62: executor.v1.HealthResponse.limits:type_name -> executor.v1.ServerLimits
+	72,  // 63: executor.v1.HealthResponse.tool_checks:type_name -> executor.v1.CheckToolResponse
+	81,  // 64: executor.v1.AcquireWorkspaceResponse.lease_expires_at:type_name -> google.protobuf.Timestamp
+	17,  // 65: executor.v1.ResolveResponse.env:type_name -> executor.v1.EnvVar
+	8,   // 66: executor.v1.GetResultResponse.state:type_name -> executor.v1.ResultState
+	10,  // 67: executor.v1.GetResultResponse.result:type_name -> executor.v1.ExecuteResponse
+	63,  // 68: executor.v1.TailProcessResponse.dropped:type_name -> executor.v1.DroppedEvent
+	10,  // 69: executor.v1.TailProcessResponse.result:type_name -> executor.v1.ExecuteResponse
+	81,  // 70: executor.v1.LogEntry.time:type_name -> google.protobuf.Timestamp
+	67,  // 71: executor.v1.ListToolsResponse.tools:type_name -> executor.v1.ToolInfo
+	81,  // 72: executor.v1.CheckToolResponse.checked_at:type_name -> google.protobuf.Timestamp
+	74,  // 73: executor.v1.TerminalInput.start:type_name -> executor.v1.TerminalStart
+	75,  // 74: executor.v1.TerminalInput.resize:type_name -> executor.v1.TerminalSize
+	9,   // 75: executor.v1.TerminalStart.request:type_name -> executor.v1.ExecuteRequest
+	75,  // 76: executor.v1.TerminalStart.size:type_name -> executor.v1.TerminalSize
+	77,  // 77: executor.v1.TerminalOutput.started:type_name -> executor.v1.TerminalStarted
+	78,  // 78: executor.v1.TerminalOutput.exited:type_name -> executor.v1.TerminalExited
+	9,   // 79: executor.v1.ExecutorService.Execute:input_type -> executor.v1.ExecuteRequest
+	12,  // 80: executor.v1.ExecutorService.ExecuteBatch:input_type -> executor.v1.ExecuteBatchRequest
+	9,   // 81: executor.v1.ExecutorService.ExecuteStream:input_type -> executor.v1.ExecuteRequest
+	26,  // 82: executor.v1.ExecutorService.ExecutePipeline:input_type -> executor.v1.PipelineRequest
+	26,  // 83: executor.v1.ExecutorService.ExecutePipelineStream:input_type -> executor.v1.PipelineRequest
+	39,  // 84: executor.v1.ExecutorService.CancelProcess:input_type -> executor.v1.CancelRequest
+	43,  // 85: executor.v1.ExecutorService.CancelPipeline:input_type -> executor.v1.CancelPipelineRequest
+	41,  // 86: executor.v1.ExecutorService.CancelByTool:input_type -> executor.v1.CancelByToolRequest
+	45,  // 87: executor.v1.ExecutorService.GetRunningProcesses:input_type -> executor.v1.GetProcessesRequest
+	50,  // 88: executor.v1.ExecutorService.Health:input_type -> executor.v1.HealthRequest
+	48,  // 89: executor.v1.ExecutorService.Ping:input_type -> executor.v1.PingRequest
+	66,  // 90: executor.v1.ExecutorService.ListTools:input_type -> executor.v1.ListToolsRequest
+	53,  // 91: executor.v1.ExecutorService.AcquireWorkspace:input_type -> executor.v1.AcquireWorkspaceRequest
+	55,  // 92: executor.v1.ExecutorService.ReleaseWorkspace:input_type -> executor.v1.ReleaseWorkspaceRequest
+	59,  // 93: executor.v1.ExecutorService.GetResult:input_type -> executor.v1.GetResultRequest
+	61,  // 94: executor.v1.ExecutorService.TailProcess:input_type -> executor.v1.TailProcessRequest
+	64,  // 95: executor.v1.ExecutorService.StreamLogs:input_type -> executor.v1.StreamLogsRequest
+	69,  // 96: executor.v1.ExecutorService.DownloadFile:input_type -> executor.v1.DownloadFileRequest
+	71,  // 97: executor.v1.ExecutorService.CheckTool:input_type -> executor.v1.CheckToolRequest
+	58,  // 98: executor.v1.ExecutorService.ReplayPipeline:input_type -> executor.v1.ReplayPipelineRequest
+	9,   // 99: executor.v1.ExecutorService.ResolveRequest:input_type -> executor.v1.ExecuteRequest
+	73,  // 100: executor.v1.ExecutorService.ExecuteTerminal:input_type -> executor.v1.TerminalInput
+	10,  // 101: executor.v1.ExecutorService.Execute:output_type -> executor.v1.ExecuteResponse
+	14,  // 102: executor.v1.ExecutorService.ExecuteBatch:output_type -> executor.v1.ExecuteBatchResponse
+	15,  // 103: executor.v1.ExecutorService.ExecuteStream:output_type -> executor.v1.ExecuteStreamResponse
+	28,  // 104: executor.v1.ExecutorService.ExecutePipeline:output_type -> executor.v1.PipelineResponse
+	31,  // 105: executor.v1.ExecutorService.ExecutePipelineStream:output_type -> executor.v1.PipelineStreamResponse
+	40,  // 106: executor.v1.ExecutorService.CancelProcess:output_type -> executor.v1.CancelResponse
+	44,  // 107: executor.v1.ExecutorService.CancelPipeline:output_type -> executor.v1.CancelPipelineResponse
+	42,  // 108: executor.v1.ExecutorService.CancelByTool:output_type -> executor.v1.CancelByToolResponse
+	47,  // 109: executor.v1.ExecutorService.GetRunningProcesses:output_type -> executor.v1.GetProcessesResponse
+	51,  // 110: executor.v1.ExecutorService.Health:output_type -> executor.v1.HealthResponse
+	49,  // 111: executor.v1.ExecutorService.Ping:output_type -> executor.v1.PingResponse
+	68,  // 112: executor.v1.ExecutorService.ListTools:output_type -> executor.v1.ListToolsResponse
+	54,  // 113: executor.v1.ExecutorService.AcquireWorkspace:output_type -> executor.v1.AcquireWorkspaceResponse
+	56,  // 114: executor.v1.ExecutorService.ReleaseWorkspace:output_type -> executor.v1.ReleaseWorkspaceResponse
+	60,  // 115: executor.v1.ExecutorService.GetResult:output_type -> executor.v1.GetResultResponse
+	62,  // 116: executor.v1.ExecutorService.TailProcess:output_type -> executor.v1.TailProcessResponse
+	65,  // 117: executor.v1.ExecutorService.StreamLogs:output_type -> executor.v1.LogEntry
+	70,  // 118: executor.v1.ExecutorService.DownloadFile:output_type -> executor.v1.FileChunk
+	72,  // 119: executor.v1.ExecutorService.CheckTool:output_type -> executor.v1.CheckToolResponse
+	31,  // 120: executor.v1.ExecutorService.ReplayPipeline:output_type -> executor.v1.PipelineStreamResponse
+	57,  // 121: executor.v1.ExecutorService.ResolveRequest:output_type -> executor.v1.ResolveResponse
+	76,  // 122: executor.v1.ExecutorService.ExecuteTerminal:output_type -> executor.v1.TerminalOutput
+	101, // [101:123] is the sub-list for method output_type
+	79,  // [79:101] is the sub-list for method input_type
+	79,  // [79:79] is the sub-list for extension type_name
+	79,  // [79:79] is the sub-list for extension extendee
+	0,   // [0:79] is the sub-list for field type_name
+}
+
+func init() { file_executor_v1_executor_proto_init() }
+func file_executor_v1_executor_proto_init() {
+	if File_executor_v1_executor_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_executor_v1_executor_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LimitEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvSnapshotEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvVar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetachedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SummaryEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestReportSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionGraph); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImagePullEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineTimeoutEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadlineEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProgressEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepStartedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepOutputEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelByToolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelByToolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPipelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPipelineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProcessesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProcessesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireWorkspaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseWorkspaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
-func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_executor_v1_executor_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *HealthResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *HealthResponse) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *HealthResponse) GetRunningCount() int32 {
-	if x != nil {
-		return x.RunningCount
-	}
-	return 0
-}
-
-func (x *HealthResponse) GetMaxConcurrent() int32 {
-	if x != nil {
-		return x.MaxConcurrent
-	}
-	return 0
-}
-
-func (x *HealthResponse) GetUptimeSeconds() float64 {
-	if x != nil {
-		return x.UptimeSeconds
-	}
-	return 0
-}
-
-func (x *HealthResponse) GetCheckedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CheckedAt
-	}
-	return nil
-}
-
-var File_executor_v1_executor_proto protoreflect.FileDescriptor
-
-var file_executor_v1_executor_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72,
-	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65,
-	0x6e, 0x76, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x85, 0x03, 0x0a, 0x0f,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f,
-	0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08,
-	0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
-	0x0b, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x4c, 0x69, 0x6e, 0x65,
-	0x12, 0x21, 0x0a, 0x0b, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x4c,
-	0x69, 0x6e, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xc9, 0x01, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53,
-	0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12,
-	0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
-	0x76, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x2a, 0x0a, 0x11,
-	0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75,
-	0x65, 0x4f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x22, 0xc3, 0x01, 0x0a, 0x0f, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x69, 0x72, 0x12, 0x2c,
-	0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x10, 0x0a, 0x03,
-	0x65, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x27,
-	0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x65, 0x70,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
-	0x65, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x73, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x43, 0x0a, 0x0e, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70,
-	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
-	0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa6, 0x03, 0x0a, 0x10, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a,
-	0x0c, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x73, 0x74,
-	0x65, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
-	0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x65, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x74, 0x65,
-	0x70, 0x73, 0x22, 0xb8, 0x02, 0x0a, 0x16, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
-	0x0c, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
-	0x64, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x12, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
-	0x00, 0x52, 0x11, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xaa, 0x01,
-	0x0a, 0x10, 0x53, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12,
-	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x0f, 0x53,
-	0x74, 0x65, 0x70, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x74,
-	0x64, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0a, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a,
-	0x0b, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x4c, 0x69, 0x6e, 0x65,
-	0x42, 0x08, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x2e, 0x0a, 0x0d, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x0e, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x38, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x16, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0xb0, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x73, 0x22, 0x64, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x70,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x0e, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x23, 0x0a, 0x0d, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61,
-	0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x75,
-	0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x32, 0x9d, 0x05,
-	0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x44, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x0f, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c,
-	0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0d, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x22, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x40, 0x5a,
-	0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x6e, 0x75, 0x6c,
-	0x6c, 0x63, 0x69, 0x2f, 0x6e, 0x65, 0x63, 0x72, 0x6f, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
-	0x72, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_executor_v1_executor_proto_rawDescOnce sync.Once
-	file_executor_v1_executor_proto_rawDescData = file_executor_v1_executor_proto_rawDesc
-)
-
-func file_executor_v1_executor_proto_rawDescGZIP() []byte {
-	file_executor_v1_executor_proto_rawDescOnce.Do(func() {
-		file_executor_v1_executor_proto_rawDescData = protoimpl.X.CompressGZIP(file_executor_v1_executor_proto_rawDescData)
-	})
-	return file_executor_v1_executor_proto_rawDescData
-}
-
-var file_executor_v1_executor_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
-var file_executor_v1_executor_proto_goTypes = []interface{}{
-	(*ExecuteRequest)(nil),         // 0: executor.v1.ExecuteRequest
-	(*ExecuteResponse)(nil),        // 1: executor.v1.ExecuteResponse
-	(*ExecuteStreamResponse)(nil),  // 2: executor.v1.ExecuteStreamResponse
-	(*BuildStep)(nil),              // 3: executor.v1.BuildStep
-	(*PipelineRequest)(nil),        // 4: executor.v1.PipelineRequest
-	(*StepResult)(nil),             // 5: executor.v1.StepResult
-	(*PipelineResponse)(nil),       // 6: executor.v1.PipelineResponse
-	(*PipelineStreamResponse)(nil), // 7: executor.v1.PipelineStreamResponse
-	(*StepStartedEvent)(nil),       // 8: executor.v1.StepStartedEvent
-	(*StepOutputEvent)(nil),        // 9: executor.v1.StepOutputEvent
-	(*CancelRequest)(nil),          // 10: executor.v1.CancelRequest
-	(*CancelResponse)(nil),         // 11: executor.v1.CancelResponse
-	(*CancelPipelineRequest)(nil),  // 12: executor.v1.CancelPipelineRequest
-	(*CancelPipelineResponse)(nil), // 13: executor.v1.CancelPipelineResponse
-	(*GetProcessesRequest)(nil),    // 14: executor.v1.GetProcessesRequest
-	(*ProcessInfo)(nil),            // 15: executor.v1.ProcessInfo
-	(*GetProcessesResponse)(nil),   // 16: executor.v1.GetProcessesResponse
-	(*HealthRequest)(nil),          // 17: executor.v1.HealthRequest
-	(*HealthResponse)(nil),         // 18: executor.v1.HealthResponse
-	(*timestamppb.Timestamp)(nil),  // 19: google.protobuf.Timestamp
-}
-var file_executor_v1_executor_proto_depIdxs = []int32{
-	19, // 0: executor.v1.ExecuteResponse.started_at:type_name -> google.protobuf.Timestamp
-	19, // 1: executor.v1.ExecuteResponse.ended_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: executor.v1.ExecuteStreamResponse.result:type_name -> executor.v1.ExecuteResponse
-	3,  // 3: executor.v1.PipelineRequest.steps:type_name -> executor.v1.BuildStep
-	1,  // 4: executor.v1.StepResult.execute_result:type_name -> executor.v1.ExecuteResponse
-	5,  // 5: executor.v1.PipelineResponse.step_results:type_name -> executor.v1.StepResult
-	19, // 6: executor.v1.PipelineResponse.started_at:type_name -> google.protobuf.Timestamp
-	19, // 7: executor.v1.PipelineResponse.ended_at:type_name -> google.protobuf.Timestamp
-	8,  // 8: executor.v1.PipelineStreamResponse.step_started:type_name -> executor.v1.StepStartedEvent
-	9,  // 9: executor.v1.PipelineStreamResponse.step_output:type_name -> executor.v1.StepOutputEvent
-	5,  // 10: executor.v1.PipelineStreamResponse.step_completed:type_name -> executor.v1.StepResult
-	6,  // 11: executor.v1.PipelineStreamResponse.pipeline_completed:type_name -> executor.v1.PipelineResponse
-	19, // 12: executor.v1.StepStartedEvent.started_at:type_name -> google.protobuf.Timestamp
-	19, // 13: executor.v1.ProcessInfo.started_at:type_name -> google.protobuf.Timestamp
-	15, // 14: executor.v1.GetProcessesResponse.processes:type_name -> executor.v1.ProcessInfo
-	19, // 15: executor.v1.HealthResponse.checked_at:type_name -> google.protobuf.Timestamp
-	0,  // 16: executor.v1.ExecutorService.Execute:input_type -> executor.v1.ExecuteRequest
-	0,  // 17: executor.v1.ExecutorService.ExecuteStream:input_type -> executor.v1.ExecuteRequest
-	4,  // 18: executor.v1.ExecutorService.ExecutePipeline:input_type -> executor.v1.PipelineRequest
-	4,  // 19: executor.v1.ExecutorService.ExecutePipelineStream:input_type -> executor.v1.PipelineRequest
-	10, // 20: executor.v1.ExecutorService.CancelProcess:input_type -> executor.v1.CancelRequest
-	12, // 21: executor.v1.ExecutorService.CancelPipeline:input_type -> executor.v1.CancelPipelineRequest
-	14, // 22: executor.v1.ExecutorService.GetRunningProcesses:input_type -> executor.v1.GetProcessesRequest
-	17, // 23: executor.v1.ExecutorService.Health:input_type -> executor.v1.HealthRequest
-	1,  // 24: executor.v1.ExecutorService.Execute:output_type -> executor.v1.ExecuteResponse
-	2,  // 25: executor.v1.ExecutorService.ExecuteStream:output_type -> executor.v1.ExecuteStreamResponse
-	6,  // 26: executor.v1.ExecutorService.ExecutePipeline:output_type -> executor.v1.PipelineResponse
-	7,  // 27: executor.v1.ExecutorService.ExecutePipelineStream:output_type -> executor.v1.PipelineStreamResponse
-	11, // 28: executor.v1.ExecutorService.CancelProcess:output_type -> executor.v1.CancelResponse
-	13, // 29: executor.v1.ExecutorService.CancelPipeline:output_type -> executor.v1.CancelPipelineResponse
-	16, // 30: executor.v1.ExecutorService.GetRunningProcesses:output_type -> executor.v1.GetProcessesResponse
-	18, // 31: executor.v1.ExecutorService.Health:output_type -> executor.v1.HealthResponse
-	24, // [24:32] is the sub-list for method output_type
-	16, // [16:24] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
-}
-
-func init() { file_executor_v1_executor_proto_init() }
-func file_executor_v1_executor_proto_init() {
-	if File_executor_v1_executor_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_executor_v1_executor_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteRequest); i {
+		file_executor_v1_executor_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1923,8 +9279,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteResponse); i {
+		file_executor_v1_executor_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayPipelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1935,8 +9291,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteStreamResponse); i {
+		file_executor_v1_executor_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1947,8 +9303,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildStep); i {
+		file_executor_v1_executor_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResultResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1959,8 +9315,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineRequest); i {
+		file_executor_v1_executor_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TailProcessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1971,8 +9327,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StepResult); i {
+		file_executor_v1_executor_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TailProcessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1983,8 +9339,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineResponse); i {
+		file_executor_v1_executor_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DroppedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1995,8 +9351,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineStreamResponse); i {
+		file_executor_v1_executor_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2007,8 +9363,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StepStartedEvent); i {
+		file_executor_v1_executor_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2019,8 +9375,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StepOutputEvent); i {
+		file_executor_v1_executor_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListToolsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2031,8 +9387,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelRequest); i {
+		file_executor_v1_executor_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToolInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2043,8 +9399,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelResponse); i {
+		file_executor_v1_executor_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListToolsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2055,8 +9411,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelPipelineRequest); i {
+		file_executor_v1_executor_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2067,8 +9423,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelPipelineResponse); i {
+		file_executor_v1_executor_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2079,8 +9435,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProcessesRequest); i {
+		file_executor_v1_executor_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckToolRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2091,8 +9447,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessInfo); i {
+		file_executor_v1_executor_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckToolResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2103,8 +9459,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProcessesResponse); i {
+		file_executor_v1_executor_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2115,8 +9471,8 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthRequest); i {
+		file_executor_v1_executor_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalStart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2127,8 +9483,44 @@ func file_executor_v1_executor_proto_init() {
 				return nil
 			}
 		}
-		file_executor_v1_executor_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthResponse); i {
+		file_executor_v1_executor_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_v1_executor_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalExited); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2140,33 +9532,71 @@ func file_executor_v1_executor_proto_init() {
 			}
 		}
 	}
-	file_executor_v1_executor_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_executor_v1_executor_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_executor_v1_executor_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*ExecuteStreamResponse_StdoutLine)(nil),
 		(*ExecuteStreamResponse_StderrLine)(nil),
 		(*ExecuteStreamResponse_Result)(nil),
+		(*ExecuteStreamResponse_Limit)(nil),
+		(*ExecuteStreamResponse_Deadline)(nil),
+		(*ExecuteStreamResponse_Progress)(nil),
+		(*ExecuteStreamResponse_Summary)(nil),
+		(*ExecuteStreamResponse_Started)(nil),
+		(*ExecuteStreamResponse_Detached)(nil),
+		(*ExecuteStreamResponse_EnvSnapshot)(nil),
+		(*ExecuteStreamResponse_StdoutChunk)(nil),
+		(*ExecuteStreamResponse_StderrChunk)(nil),
+		(*ExecuteStreamResponse_RateLimited)(nil),
 	}
-	file_executor_v1_executor_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_executor_v1_executor_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_executor_v1_executor_proto_msgTypes[22].OneofWrappers = []interface{}{
 		(*PipelineStreamResponse_StepStarted)(nil),
 		(*PipelineStreamResponse_StepOutput)(nil),
 		(*PipelineStreamResponse_StepCompleted)(nil),
 		(*PipelineStreamResponse_PipelineCompleted)(nil),
+		(*PipelineStreamResponse_Limit)(nil),
+		(*PipelineStreamResponse_Deadline)(nil),
+		(*PipelineStreamResponse_Progress)(nil),
+		(*PipelineStreamResponse_EnvSnapshot)(nil),
+		(*PipelineStreamResponse_PipelineTimeout)(nil),
+		(*PipelineStreamResponse_ImagePull)(nil),
+		(*PipelineStreamResponse_RateLimited)(nil),
 	}
-	file_executor_v1_executor_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_executor_v1_executor_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*StepOutputEvent_StdoutLine)(nil),
 		(*StepOutputEvent_StderrLine)(nil),
+		(*StepOutputEvent_StdoutChunk)(nil),
+		(*StepOutputEvent_StderrChunk)(nil),
+	}
+	file_executor_v1_executor_proto_msgTypes[53].OneofWrappers = []interface{}{
+		(*TailProcessResponse_StdoutLine)(nil),
+		(*TailProcessResponse_StderrLine)(nil),
+		(*TailProcessResponse_Dropped)(nil),
+		(*TailProcessResponse_Result)(nil),
+	}
+	file_executor_v1_executor_proto_msgTypes[64].OneofWrappers = []interface{}{
+		(*TerminalInput_Start)(nil),
+		(*TerminalInput_Data)(nil),
+		(*TerminalInput_Resize)(nil),
+	}
+	file_executor_v1_executor_proto_msgTypes[67].OneofWrappers = []interface{}{
+		(*TerminalOutput_Started)(nil),
+		(*TerminalOutput_Data)(nil),
+		(*TerminalOutput_Exited)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_executor_v1_executor_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      9,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_executor_v1_executor_proto_goTypes,
 		DependencyIndexes: file_executor_v1_executor_proto_depIdxs,
+		EnumInfos:         file_executor_v1_executor_proto_enumTypes,
 		MessageInfos:      file_executor_v1_executor_proto_msgTypes,
 	}.Build()
 	File_executor_v1_executor_proto = out.File
@@ -20,13 +20,27 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	ExecutorService_Execute_FullMethodName               = "/executor.v1.ExecutorService/Execute"
+	ExecutorService_ExecuteBatch_FullMethodName          = "/executor.v1.ExecutorService/ExecuteBatch"
 	ExecutorService_ExecuteStream_FullMethodName         = "/executor.v1.ExecutorService/ExecuteStream"
 	ExecutorService_ExecutePipeline_FullMethodName       = "/executor.v1.ExecutorService/ExecutePipeline"
 	ExecutorService_ExecutePipelineStream_FullMethodName = "/executor.v1.ExecutorService/ExecutePipelineStream"
 	ExecutorService_CancelProcess_FullMethodName         = "/executor.v1.ExecutorService/CancelProcess"
 	ExecutorService_CancelPipeline_FullMethodName        = "/executor.v1.ExecutorService/CancelPipeline"
+	ExecutorService_CancelByTool_FullMethodName          = "/executor.v1.ExecutorService/CancelByTool"
 	ExecutorService_GetRunningProcesses_FullMethodName   = "/executor.v1.ExecutorService/GetRunningProcesses"
 	ExecutorService_Health_FullMethodName                = "/executor.v1.ExecutorService/Health"
+	ExecutorService_Ping_FullMethodName                  = "/executor.v1.ExecutorService/Ping"
+	ExecutorService_ListTools_FullMethodName             = "/executor.v1.ExecutorService/ListTools"
+	ExecutorService_AcquireWorkspace_FullMethodName      = "/executor.v1.ExecutorService/AcquireWorkspace"
+	ExecutorService_ReleaseWorkspace_FullMethodName      = "/executor.v1.ExecutorService/ReleaseWorkspace"
+	ExecutorService_GetResult_FullMethodName             = "/executor.v1.ExecutorService/GetResult"
+	ExecutorService_TailProcess_FullMethodName           = "/executor.v1.ExecutorService/TailProcess"
+	ExecutorService_StreamLogs_FullMethodName            = "/executor.v1.ExecutorService/StreamLogs"
+	ExecutorService_DownloadFile_FullMethodName          = "/executor.v1.ExecutorService/DownloadFile"
+	ExecutorService_CheckTool_FullMethodName             = "/executor.v1.ExecutorService/CheckTool"
+	ExecutorService_ReplayPipeline_FullMethodName        = "/executor.v1.ExecutorService/ReplayPipeline"
+	ExecutorService_ResolveRequest_FullMethodName        = "/executor.v1.ExecutorService/ResolveRequest"
+	ExecutorService_ExecuteTerminal_FullMethodName       = "/executor.v1.ExecutorService/ExecuteTerminal"
 )
 
 // ExecutorServiceClient is the client API for ExecutorService service.
@@ -35,6 +49,8 @@ const (
 type ExecutorServiceClient interface {
 	// Execute runs a single command and returns the result
 	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error)
+	// ExecuteBatch runs many independent commands concurrently
+	ExecuteBatch(ctx context.Context, in *ExecuteBatchRequest, opts ...grpc.CallOption) (*ExecuteBatchResponse, error)
 	// ExecuteStream runs a command and streams output in real-time
 	ExecuteStream(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (ExecutorService_ExecuteStreamClient, error)
 	// ExecutePipeline runs a multi-step pipeline
@@ -45,10 +61,47 @@ type ExecutorServiceClient interface {
 	CancelProcess(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
 	// CancelPipeline cancels a running pipeline by ID
 	CancelPipeline(ctx context.Context, in *CancelPipelineRequest, opts ...grpc.CallOption) (*CancelPipelineResponse, error)
+	// CancelByTool cancels every running process of a tool
+	CancelByTool(ctx context.Context, in *CancelByToolRequest, opts ...grpc.CallOption) (*CancelByToolResponse, error)
 	// GetRunningProcesses returns all currently running processes
 	GetRunningProcesses(ctx context.Context, in *GetProcessesRequest, opts ...grpc.CallOption) (*GetProcessesResponse, error)
 	// Health returns the service health status
 	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	// Ping answers without inspecting server state, for frequent liveness
+	// probes where Health is too heavy
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	// ListTools returns the allowed tools and the policies applied to them
+	ListTools(ctx context.Context, in *ListToolsRequest, opts ...grpc.CallOption) (*ListToolsResponse, error)
+	// AcquireWorkspace creates or reuses a named workspace directory, taking
+	// a lease on it
+	AcquireWorkspace(ctx context.Context, in *AcquireWorkspaceRequest, opts ...grpc.CallOption) (*AcquireWorkspaceResponse, error)
+	// ReleaseWorkspace drops a lease on a named workspace, removing it once
+	// no leases or running executions remain
+	ReleaseWorkspace(ctx context.Context, in *ReleaseWorkspaceRequest, opts ...grpc.CallOption) (*ReleaseWorkspaceResponse, error)
+	// GetResult returns the result of an ExecuteStream call that detached
+	GetResult(ctx context.Context, in *GetResultRequest, opts ...grpc.CallOption) (*GetResultResponse, error)
+	// TailProcess streams a running process's recent and live output to any
+	// number of subscribers, ending with its result
+	TailProcess(ctx context.Context, in *TailProcessRequest, opts ...grpc.CallOption) (ExecutorService_TailProcessClient, error)
+	// StreamLogs streams the server's own log entries (see executor.log_stream)
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (ExecutorService_StreamLogsClient, error)
+	// DownloadFile streams a file from under the workspace base in chunks,
+	// e.g. a large output a step wrote (see executor.download)
+	DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (ExecutorService_DownloadFileClient, error)
+	// CheckTool runs a tool's health probe (see executor.tool_healthchecks)
+	CheckTool(ctx context.Context, in *CheckToolRequest, opts ...grpc.CallOption) (*CheckToolResponse, error)
+	// ReplayPipeline re-sends the recorded events of an ExecutePipelineStream
+	// call in order (see executor.pipeline_replay)
+	ReplayPipeline(ctx context.Context, in *ReplayPipelineRequest, opts ...grpc.CallOption) (ExecutorService_ReplayPipelineClient, error)
+	// ResolveRequest validates and resolves a request as Execute would,
+	// returning what would run without running it. Only the allowlist is
+	// checked: compiled-in validators are not consulted and tool versions are
+	// reported from earlier checks
+	ResolveRequest(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ResolveResponse, error)
+	// ExecuteTerminal runs a command on a pseudo-terminal, streaming the
+	// client's keystrokes and window size changes to it and its terminal
+	// output back (see executor.terminal)
+	ExecuteTerminal(ctx context.Context, opts ...grpc.CallOption) (ExecutorService_ExecuteTerminalClient, error)
 }
 
 type executorServiceClient struct {
@@ -68,6 +121,15 @@ func (c *executorServiceClient) Execute(ctx context.Context, in *ExecuteRequest,
 	return out, nil
 }
 
+func (c *executorServiceClient) ExecuteBatch(ctx context.Context, in *ExecuteBatchRequest, opts ...grpc.CallOption) (*ExecuteBatchResponse, error) {
+	out := new(ExecuteBatchResponse)
+	err := c.cc.Invoke(ctx, ExecutorService_ExecuteBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *executorServiceClient) ExecuteStream(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (ExecutorService_ExecuteStreamClient, error) {
 	stream, err := c.cc.NewStream(ctx, &ExecutorService_ServiceDesc.Streams[0], ExecutorService_ExecuteStream_FullMethodName, opts...)
 	if err != nil {
@@ -159,6 +221,15 @@ func (c *executorServiceClient) CancelPipeline(ctx context.Context, in *CancelPi
 	return out, nil
 }
 
+func (c *executorServiceClient) CancelByTool(ctx context.Context, in *CancelByToolRequest, opts ...grpc.CallOption) (*CancelByToolResponse, error) {
+	out := new(CancelByToolResponse)
+	err := c.cc.Invoke(ctx, ExecutorService_CancelByTool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *executorServiceClient) GetRunningProcesses(ctx context.Context, in *GetProcessesRequest, opts ...grpc.CallOption) (*GetProcessesResponse, error) {
 	out := new(GetProcessesResponse)
 	err := c.cc.Invoke(ctx, ExecutorService_GetRunningProcesses_FullMethodName, in, out, opts...)
@@ -177,12 +248,236 @@ func (c *executorServiceClient) Health(ctx context.Context, in *HealthRequest, o
 	return out, nil
 }
 
+func (c *executorServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, ExecutorService_Ping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorServiceClient) ListTools(ctx context.Context, in *ListToolsRequest, opts ...grpc.CallOption) (*ListToolsResponse, error) {
+	out := new(ListToolsResponse)
+	err := c.cc.Invoke(ctx, ExecutorService_ListTools_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorServiceClient) AcquireWorkspace(ctx context.Context, in *AcquireWorkspaceRequest, opts ...grpc.CallOption) (*AcquireWorkspaceResponse, error) {
+	out := new(AcquireWorkspaceResponse)
+	err := c.cc.Invoke(ctx, ExecutorService_AcquireWorkspace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorServiceClient) ReleaseWorkspace(ctx context.Context, in *ReleaseWorkspaceRequest, opts ...grpc.CallOption) (*ReleaseWorkspaceResponse, error) {
+	out := new(ReleaseWorkspaceResponse)
+	err := c.cc.Invoke(ctx, ExecutorService_ReleaseWorkspace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorServiceClient) GetResult(ctx context.Context, in *GetResultRequest, opts ...grpc.CallOption) (*GetResultResponse, error) {
+	out := new(GetResultResponse)
+	err := c.cc.Invoke(ctx, ExecutorService_GetResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorServiceClient) TailProcess(ctx context.Context, in *TailProcessRequest, opts ...grpc.CallOption) (ExecutorService_TailProcessClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExecutorService_ServiceDesc.Streams[2], ExecutorService_TailProcess_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorServiceTailProcessClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExecutorService_TailProcessClient interface {
+	Recv() (*TailProcessResponse, error)
+	grpc.ClientStream
+}
+
+type executorServiceTailProcessClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorServiceTailProcessClient) Recv() (*TailProcessResponse, error) {
+	m := new(TailProcessResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executorServiceClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (ExecutorService_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExecutorService_ServiceDesc.Streams[3], ExecutorService_StreamLogs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorServiceStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExecutorService_StreamLogsClient interface {
+	Recv() (*LogEntry, error)
+	grpc.ClientStream
+}
+
+type executorServiceStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorServiceStreamLogsClient) Recv() (*LogEntry, error) {
+	m := new(LogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executorServiceClient) DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (ExecutorService_DownloadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExecutorService_ServiceDesc.Streams[4], ExecutorService_DownloadFile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorServiceDownloadFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExecutorService_DownloadFileClient interface {
+	Recv() (*FileChunk, error)
+	grpc.ClientStream
+}
+
+type executorServiceDownloadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorServiceDownloadFileClient) Recv() (*FileChunk, error) {
+	m := new(FileChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executorServiceClient) CheckTool(ctx context.Context, in *CheckToolRequest, opts ...grpc.CallOption) (*CheckToolResponse, error) {
+	out := new(CheckToolResponse)
+	err := c.cc.Invoke(ctx, ExecutorService_CheckTool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorServiceClient) ReplayPipeline(ctx context.Context, in *ReplayPipelineRequest, opts ...grpc.CallOption) (ExecutorService_ReplayPipelineClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExecutorService_ServiceDesc.Streams[5], ExecutorService_ReplayPipeline_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorServiceReplayPipelineClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExecutorService_ReplayPipelineClient interface {
+	Recv() (*PipelineStreamResponse, error)
+	grpc.ClientStream
+}
+
+type executorServiceReplayPipelineClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorServiceReplayPipelineClient) Recv() (*PipelineStreamResponse, error) {
+	m := new(PipelineStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executorServiceClient) ResolveRequest(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ResolveResponse, error) {
+	out := new(ResolveResponse)
+	err := c.cc.Invoke(ctx, ExecutorService_ResolveRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorServiceClient) ExecuteTerminal(ctx context.Context, opts ...grpc.CallOption) (ExecutorService_ExecuteTerminalClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExecutorService_ServiceDesc.Streams[6], ExecutorService_ExecuteTerminal_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorServiceExecuteTerminalClient{stream}
+	return x, nil
+}
+
+type ExecutorService_ExecuteTerminalClient interface {
+	Send(*TerminalInput) error
+	Recv() (*TerminalOutput, error)
+	grpc.ClientStream
+}
+
+type executorServiceExecuteTerminalClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorServiceExecuteTerminalClient) Send(m *TerminalInput) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *executorServiceExecuteTerminalClient) Recv() (*TerminalOutput, error) {
+	m := new(TerminalOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ExecutorServiceServer is the server API for ExecutorService service.
 // All implementations must embed UnimplementedExecutorServiceServer
 // for forward compatibility
 type ExecutorServiceServer interface {
 	// Execute runs a single command and returns the result
 	Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error)
+	// ExecuteBatch runs many independent commands concurrently
+	ExecuteBatch(context.Context, *ExecuteBatchRequest) (*ExecuteBatchResponse, error)
 	// ExecuteStream runs a command and streams output in real-time
 	ExecuteStream(*ExecuteRequest, ExecutorService_ExecuteStreamServer) error
 	// ExecutePipeline runs a multi-step pipeline
@@ -193,10 +488,47 @@ type ExecutorServiceServer interface {
 	CancelProcess(context.Context, *CancelRequest) (*CancelResponse, error)
 	// CancelPipeline cancels a running pipeline by ID
 	CancelPipeline(context.Context, *CancelPipelineRequest) (*CancelPipelineResponse, error)
+	// CancelByTool cancels every running process of a tool
+	CancelByTool(context.Context, *CancelByToolRequest) (*CancelByToolResponse, error)
 	// GetRunningProcesses returns all currently running processes
 	GetRunningProcesses(context.Context, *GetProcessesRequest) (*GetProcessesResponse, error)
 	// Health returns the service health status
 	Health(context.Context, *HealthRequest) (*HealthResponse, error)
+	// Ping answers without inspecting server state, for frequent liveness
+	// probes where Health is too heavy
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// ListTools returns the allowed tools and the policies applied to them
+	ListTools(context.Context, *ListToolsRequest) (*ListToolsResponse, error)
+	// AcquireWorkspace creates or reuses a named workspace directory, taking
+	// a lease on it
+	AcquireWorkspace(context.Context, *AcquireWorkspaceRequest) (*AcquireWorkspaceResponse, error)
+	// ReleaseWorkspace drops a lease on a named workspace, removing it once
+	// no leases or running executions remain
+	ReleaseWorkspace(context.Context, *ReleaseWorkspaceRequest) (*ReleaseWorkspaceResponse, error)
+	// GetResult returns the result of an ExecuteStream call that detached
+	GetResult(context.Context, *GetResultRequest) (*GetResultResponse, error)
+	// TailProcess streams a running process's recent and live output to any
+	// number of subscribers, ending with its result
+	TailProcess(*TailProcessRequest, ExecutorService_TailProcessServer) error
+	// StreamLogs streams the server's own log entries (see executor.log_stream)
+	StreamLogs(*StreamLogsRequest, ExecutorService_StreamLogsServer) error
+	// DownloadFile streams a file from under the workspace base in chunks,
+	// e.g. a large output a step wrote (see executor.download)
+	DownloadFile(*DownloadFileRequest, ExecutorService_DownloadFileServer) error
+	// CheckTool runs a tool's health probe (see executor.tool_healthchecks)
+	CheckTool(context.Context, *CheckToolRequest) (*CheckToolResponse, error)
+	// ReplayPipeline re-sends the recorded events of an ExecutePipelineStream
+	// call in order (see executor.pipeline_replay)
+	ReplayPipeline(*ReplayPipelineRequest, ExecutorService_ReplayPipelineServer) error
+	// ResolveRequest validates and resolves a request as Execute would,
+	// returning what would run without running it. Only the allowlist is
+	// checked: compiled-in validators are not consulted and tool versions are
+	// reported from earlier checks
+	ResolveRequest(context.Context, *ExecuteRequest) (*ResolveResponse, error)
+	// ExecuteTerminal runs a command on a pseudo-terminal, streaming the
+	// client's keystrokes and window size changes to it and its terminal
+	// output back (see executor.terminal)
+	ExecuteTerminal(ExecutorService_ExecuteTerminalServer) error
 	mustEmbedUnimplementedExecutorServiceServer()
 }
 
@@ -207,6 +539,9 @@ type UnimplementedExecutorServiceServer struct {
 func (UnimplementedExecutorServiceServer) Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
+func (UnimplementedExecutorServiceServer) ExecuteBatch(context.Context, *ExecuteBatchRequest) (*ExecuteBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBatch not implemented")
+}
 func (UnimplementedExecutorServiceServer) ExecuteStream(*ExecuteRequest, ExecutorService_ExecuteStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method ExecuteStream not implemented")
 }
@@ -222,12 +557,51 @@ func (UnimplementedExecutorServiceServer) CancelProcess(context.Context, *Cancel
 func (UnimplementedExecutorServiceServer) CancelPipeline(context.Context, *CancelPipelineRequest) (*CancelPipelineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelPipeline not implemented")
 }
+func (UnimplementedExecutorServiceServer) CancelByTool(context.Context, *CancelByToolRequest) (*CancelByToolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelByTool not implemented")
+}
 func (UnimplementedExecutorServiceServer) GetRunningProcesses(context.Context, *GetProcessesRequest) (*GetProcessesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRunningProcesses not implemented")
 }
 func (UnimplementedExecutorServiceServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
 }
+func (UnimplementedExecutorServiceServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedExecutorServiceServer) ListTools(context.Context, *ListToolsRequest) (*ListToolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTools not implemented")
+}
+func (UnimplementedExecutorServiceServer) AcquireWorkspace(context.Context, *AcquireWorkspaceRequest) (*AcquireWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireWorkspace not implemented")
+}
+func (UnimplementedExecutorServiceServer) ReleaseWorkspace(context.Context, *ReleaseWorkspaceRequest) (*ReleaseWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseWorkspace not implemented")
+}
+func (UnimplementedExecutorServiceServer) GetResult(context.Context, *GetResultRequest) (*GetResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResult not implemented")
+}
+func (UnimplementedExecutorServiceServer) TailProcess(*TailProcessRequest, ExecutorService_TailProcessServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailProcess not implemented")
+}
+func (UnimplementedExecutorServiceServer) StreamLogs(*StreamLogsRequest, ExecutorService_StreamLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
+func (UnimplementedExecutorServiceServer) DownloadFile(*DownloadFileRequest, ExecutorService_DownloadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
+func (UnimplementedExecutorServiceServer) CheckTool(context.Context, *CheckToolRequest) (*CheckToolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTool not implemented")
+}
+func (UnimplementedExecutorServiceServer) ReplayPipeline(*ReplayPipelineRequest, ExecutorService_ReplayPipelineServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReplayPipeline not implemented")
+}
+func (UnimplementedExecutorServiceServer) ResolveRequest(context.Context, *ExecuteRequest) (*ResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveRequest not implemented")
+}
+func (UnimplementedExecutorServiceServer) ExecuteTerminal(ExecutorService_ExecuteTerminalServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExecuteTerminal not implemented")
+}
 func (UnimplementedExecutorServiceServer) mustEmbedUnimplementedExecutorServiceServer() {}
 
 // UnsafeExecutorServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -259,6 +633,24 @@ func _ExecutorService_Execute_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorService_ExecuteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).ExecuteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorService_ExecuteBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).ExecuteBatch(ctx, req.(*ExecuteBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExecutorService_ExecuteStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ExecuteRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -355,6 +747,24 @@ func _ExecutorService_CancelPipeline_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorService_CancelByTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelByToolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).CancelByTool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorService_CancelByTool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).CancelByTool(ctx, req.(*CancelByToolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExecutorService_GetRunningProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProcessesRequest)
 	if err := dec(in); err != nil {
@@ -391,6 +801,242 @@ func _ExecutorService_Health_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorService_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorService_ListTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListToolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).ListTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorService_ListTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).ListTools(ctx, req.(*ListToolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorService_AcquireWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).AcquireWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorService_AcquireWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).AcquireWorkspace(ctx, req.(*AcquireWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorService_ReleaseWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).ReleaseWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorService_ReleaseWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).ReleaseWorkspace(ctx, req.(*ReleaseWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorService_GetResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).GetResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorService_GetResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).GetResult(ctx, req.(*GetResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorService_TailProcess_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailProcessRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutorServiceServer).TailProcess(m, &executorServiceTailProcessServer{stream})
+}
+
+type ExecutorService_TailProcessServer interface {
+	Send(*TailProcessResponse) error
+	grpc.ServerStream
+}
+
+type executorServiceTailProcessServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorServiceTailProcessServer) Send(m *TailProcessResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutorService_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutorServiceServer).StreamLogs(m, &executorServiceStreamLogsServer{stream})
+}
+
+type ExecutorService_StreamLogsServer interface {
+	Send(*LogEntry) error
+	grpc.ServerStream
+}
+
+type executorServiceStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorServiceStreamLogsServer) Send(m *LogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutorService_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutorServiceServer).DownloadFile(m, &executorServiceDownloadFileServer{stream})
+}
+
+type ExecutorService_DownloadFileServer interface {
+	Send(*FileChunk) error
+	grpc.ServerStream
+}
+
+type executorServiceDownloadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorServiceDownloadFileServer) Send(m *FileChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutorService_CheckTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckToolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).CheckTool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorService_CheckTool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).CheckTool(ctx, req.(*CheckToolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorService_ReplayPipeline_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplayPipelineRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutorServiceServer).ReplayPipeline(m, &executorServiceReplayPipelineServer{stream})
+}
+
+type ExecutorService_ReplayPipelineServer interface {
+	Send(*PipelineStreamResponse) error
+	grpc.ServerStream
+}
+
+type executorServiceReplayPipelineServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorServiceReplayPipelineServer) Send(m *PipelineStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutorService_ResolveRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServiceServer).ResolveRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorService_ResolveRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServiceServer).ResolveRequest(ctx, req.(*ExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorService_ExecuteTerminal_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExecutorServiceServer).ExecuteTerminal(&executorServiceExecuteTerminalServer{stream})
+}
+
+type ExecutorService_ExecuteTerminalServer interface {
+	Send(*TerminalOutput) error
+	Recv() (*TerminalInput, error)
+	grpc.ServerStream
+}
+
+type executorServiceExecuteTerminalServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorServiceExecuteTerminalServer) Send(m *TerminalOutput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *executorServiceExecuteTerminalServer) Recv() (*TerminalInput, error) {
+	m := new(TerminalInput)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ExecutorService_ServiceDesc is the grpc.ServiceDesc for ExecutorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -402,6 +1048,10 @@ var ExecutorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Execute",
 			Handler:    _ExecutorService_Execute_Handler,
 		},
+		{
+			MethodName: "ExecuteBatch",
+			Handler:    _ExecutorService_ExecuteBatch_Handler,
+		},
 		{
 			MethodName: "ExecutePipeline",
 			Handler:    _ExecutorService_ExecutePipeline_Handler,
@@ -414,6 +1064,10 @@ var ExecutorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelPipeline",
 			Handler:    _ExecutorService_CancelPipeline_Handler,
 		},
+		{
+			MethodName: "CancelByTool",
+			Handler:    _ExecutorService_CancelByTool_Handler,
+		},
 		{
 			MethodName: "GetRunningProcesses",
 			Handler:    _ExecutorService_GetRunningProcesses_Handler,
@@ -422,6 +1076,34 @@ var ExecutorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Health",
 			Handler:    _ExecutorService_Health_Handler,
 		},
+		{
+			MethodName: "Ping",
+			Handler:    _ExecutorService_Ping_Handler,
+		},
+		{
+			MethodName: "ListTools",
+			Handler:    _ExecutorService_ListTools_Handler,
+		},
+		{
+			MethodName: "AcquireWorkspace",
+			Handler:    _ExecutorService_AcquireWorkspace_Handler,
+		},
+		{
+			MethodName: "ReleaseWorkspace",
+			Handler:    _ExecutorService_ReleaseWorkspace_Handler,
+		},
+		{
+			MethodName: "GetResult",
+			Handler:    _ExecutorService_GetResult_Handler,
+		},
+		{
+			MethodName: "CheckTool",
+			Handler:    _ExecutorService_CheckTool_Handler,
+		},
+		{
+			MethodName: "ResolveRequest",
+			Handler:    _ExecutorService_ResolveRequest_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -434,6 +1116,32 @@ var ExecutorService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ExecutorService_ExecutePipelineStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TailProcess",
+			Handler:       _ExecutorService_TailProcess_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _ExecutorService_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadFile",
+			Handler:       _ExecutorService_DownloadFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReplayPipeline",
+			Handler:       _ExecutorService_ReplayPipeline_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExecuteTerminal",
+			Handler:       _ExecutorService_ExecuteTerminal_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "executor/v1/executor.proto",
 }
@@ -16,9 +16,14 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
+// toolsReloadDelay lets an editor finish writing the allowed tools file
+// before it is reloaded
+const toolsReloadDelay = 500 * time.Millisecond
+
 // App is the main application struct
 type App struct {
 	config     *config.Config
@@ -35,8 +40,18 @@ func New(cfg *config.Config) (*App, error) {
 		return nil, fmt.Errorf("failed to initialize logger: %w", err)
 	}
 
+	// Tee logs to StreamLogs clients
+	var logs *grpcserver.LogBroadcaster
+	if cfg.Executor.LogStream.Enabled {
+		logs = grpcserver.NewLogBroadcaster(cfg.Executor.LogStream.QueueEntries)
+		logger = logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return zapcore.NewTee(core, logs.Core())
+		}))
+	}
+
 	// Create executor server
 	execServer := grpcserver.NewExecutorServer(&cfg.Executor, logger)
+	execServer.SetLogBroadcaster(logs)
 
 	return &App{
 		config:     cfg,
@@ -53,12 +68,40 @@ func (a *App) Run() error {
 	if err != nil {
 		return fmt.Errorf("failed to listen on %s: %w", address, err)
 	}
+	listener = a.execServer.LimitListener(listener, a.config.Server.MaxConnections)
 
 	// Create gRPC server
-	a.grpcServer = grpc.NewServer(
-		grpc.MaxRecvMsgSize(50*1024*1024), // 50MB max message size
-		grpc.MaxSendMsgSize(50*1024*1024),
-	)
+	enabled := grpcserver.NewMethodFilter(a.config.Server.EnabledMethods)
+	limits := grpcserver.NewMethodLimits(a.config.Server.MethodLimits)
+	unary := []grpc.UnaryServerInterceptor{enabled.UnaryInterceptor(), limits.UnaryInterceptor()}
+	stream := []grpc.StreamServerInterceptor{enabled.StreamInterceptor(), limits.StreamInterceptor()}
+	if tenancy := a.config.Executor.TenantIsolation; tenancy.Enabled {
+		tenants := grpcserver.NewTenantAuth(tenancy)
+		unary = append(unary, tenants.UnaryInterceptor())
+		stream = append(stream, tenants.StreamInterceptor())
+	}
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(grpcserver.MaxMessageBytes),
+		grpc.MaxSendMsgSize(grpcserver.MaxMessageBytes),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+
+	// Serve TLS, picking up renewed certificates for new connections
+	if tlsCfg := a.config.Server.TLS; tlsCfg.CertFile != "" {
+		certs, err := newCertReloader(tlsCfg, a.logger)
+		if err != nil {
+			listener.Close()
+			return err
+		}
+		stopWatch := make(chan struct{})
+		defer close(stopWatch)
+		if err := certs.watch(stopWatch); err != nil {
+			a.logger.Warn("TLS certificate files are not watched, renewals need a restart", zap.Error(err))
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(certs.tlsConfig())))
+	}
+	a.grpcServer = grpc.NewServer(opts...)
 
 	// Register executor service
 	executorv1.RegisterExecutorServiceServer(a.grpcServer, a.execServer)
@@ -66,6 +109,40 @@ func (a *App) Run() error {
 	// Enable reflection for debugging (grpcurl, etc.)
 	reflection.Register(a.grpcServer)
 
+	// Enabled methods are checked against what is registered
+	if err := enabled.Validate(a.grpcServer.GetServiceInfo()); err != nil {
+		listener.Close()
+		return fmt.Errorf("server.enabled_methods: %w", err)
+	}
+	if len(enabled) > 0 {
+		a.logger.Info("serving only enabled methods", zap.Strings("methods", a.config.Server.EnabledMethods))
+	}
+
+	// Reload the allowed tools file on SIGHUP and whenever it changes
+	if toolsFile := a.config.Executor.AllowedToolsFile; toolsFile != "" {
+		reloadTools := func() {
+			if err := a.execServer.ReloadTools(); err != nil {
+				a.logger.Error("failed to reload allowed tools, keeping current list", zap.Error(err))
+			}
+		}
+		go func() {
+			hup := make(chan os.Signal, 1)
+			signal.Notify(hup, syscall.SIGHUP)
+			for range hup {
+				reloadTools()
+			}
+		}()
+
+		stopToolsWatch := make(chan struct{})
+		defer close(stopToolsWatch)
+		err := watchFiles([]string{toolsFile}, toolsReloadDelay, stopToolsWatch, func(err error) {
+			a.logger.Warn("allowed tools file watch error", zap.Error(err))
+		}, reloadTools)
+		if err != nil {
+			a.logger.Warn("allowed tools file is not watched, changes need a SIGHUP", zap.Error(err))
+		}
+	}
+
 	// Graceful shutdown
 	go func() {
 		quit := make(chan os.Signal, 1)
@@ -93,8 +170,11 @@ func (a *App) Run() error {
 	a.logger.Info("starting gRPC server",
 		zap.String("address", address),
 		zap.Strings("allowed_tools", a.config.Executor.AllowedTools),
+		zap.Bool("tls", a.config.Server.TLS.CertFile != ""),
 	)
 
+	defer a.execServer.Close()
+
 	if err := a.grpcServer.Serve(listener); err != nil {
 		return fmt.Errorf("gRPC server error: %w", err)
 	}
@@ -104,20 +184,7 @@ func (a *App) Run() error {
 
 // ExecuteCommand runs a single command (CLI mode)
 func (a *App) ExecuteCommand(tool, args, workdir string) error {
-	// Parse comma-separated args
-	var argList []string
-	if args != "" {
-		argList = strings.Split(args, ",")
-		for i := range argList {
-			argList[i] = strings.TrimSpace(argList[i])
-		}
-	}
-
-	req := &executorv1.ExecuteRequest{
-		Tool:    tool,
-		Args:    argList,
-		WorkDir: workdir,
-	}
+	req := commandRequest(tool, args, workdir)
 
 	result, err := a.execServer.Execute(context.Background(), req)
 	if err != nil {
@@ -148,6 +215,63 @@ func (a *App) ExecuteCommand(tool, args, workdir string) error {
 	return nil
 }
 
+// ExecuteCommandStream runs a single command, printing its output as it is
+// produced. The ndjson format prints every stream event as a JSON line.
+func (a *App) ExecuteCommandStream(tool, args, workdir, format string) error {
+	if format != OutputText && format != OutputNDJSON {
+		return fmt.Errorf("unknown output format '%s' (want %s or %s)", format, OutputText, OutputNDJSON)
+	}
+
+	stream := newCLIStream(context.Background(), format)
+	if err := a.execServer.ExecuteStream(commandRequest(tool, args, workdir), stream); err != nil {
+		a.logger.Error("command execution failed", zap.Error(err))
+		return err
+	}
+
+	if result := stream.result; result != nil && !result.Success {
+		return &ExitError{Code: exitStatus(result.ExitCode)}
+	}
+	return nil
+}
+
+// ExitError reports a failed remote command, whose exit code the CLI exits
+// with
+type ExitError struct {
+	Code int
+}
+
+// Error implements error
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("command failed with exit code %d", e.Code)
+}
+
+// exitStatus maps a failed command's exit code to a process exit status.
+// Codes without an exit status, such as -1 for a timeout, become 1.
+func exitStatus(code int32) int {
+	if code <= 0 {
+		return 1
+	}
+	return int(min(code, 255))
+}
+
+// commandRequest builds the request of the execute command from its
+// comma-separated args
+func commandRequest(tool, args, workdir string) *executorv1.ExecuteRequest {
+	var argList []string
+	if args != "" {
+		argList = strings.Split(args, ",")
+		for i := range argList {
+			argList[i] = strings.TrimSpace(argList[i])
+		}
+	}
+
+	return &executorv1.ExecuteRequest{
+		Tool:    tool,
+		Args:    argList,
+		WorkDir: workdir,
+	}
+}
+
 // initLogger initializes the Zap logger
 func initLogger(cfg config.LoggingConfig) (*zap.Logger, error) {
 	var zapCfg zap.Config
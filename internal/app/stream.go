@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Output formats of the execute command's --stream mode
+const (
+	OutputText   = "text"
+	OutputNDJSON = "ndjson"
+)
+
+// streamEvent is one line of ndjson stream output
+type streamEvent struct {
+	Type      string          `json:"type"`
+	Stream    string          `json:"stream,omitempty"`
+	Line      *string         `json:"line,omitempty"`
+	Data      []byte          `json:"data,omitempty"`
+	Timestamp string          `json:"timestamp"`
+	ExitCode  *int32          `json:"exit_code,omitempty"`
+	Success   *bool           `json:"success,omitempty"`
+	Event     json.RawMessage `json:"event,omitempty"`
+}
+
+// cliStream prints the events of an in-process ExecuteStream call
+type cliStream struct {
+	ctx    context.Context
+	format string
+	stdout io.Writer
+	stderr io.Writer
+	enc    *json.Encoder
+
+	// result is the final ExecuteResponse, nil until it was sent
+	result *executorv1.ExecuteResponse
+}
+
+// newCLIStream prints events to stdout and stderr in the given format
+func newCLIStream(ctx context.Context, format string) *cliStream {
+	return &cliStream{
+		ctx:    ctx,
+		format: format,
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+		enc:    json.NewEncoder(os.Stdout),
+	}
+}
+
+// Send implements executorv1.ExecutorService_ExecuteStreamServer
+func (c *cliStream) Send(msg *executorv1.ExecuteStreamResponse) error {
+	if result := msg.GetResult(); result != nil {
+		c.result = result
+	}
+	if c.format == OutputNDJSON {
+		return c.enc.Encode(ndjsonEvent(msg))
+	}
+	return c.printText(msg)
+}
+
+// printText writes output lines to the matching stream and the result
+// summary to stdout, leaving out the other events
+func (c *cliStream) printText(msg *executorv1.ExecuteStreamResponse) error {
+	var err error
+	switch out := msg.Output.(type) {
+	case *executorv1.ExecuteStreamResponse_StdoutLine:
+		_, err = fmt.Fprintln(c.stdout, out.StdoutLine)
+	case *executorv1.ExecuteStreamResponse_StderrLine:
+		_, err = fmt.Fprintln(c.stderr, out.StderrLine)
+	case *executorv1.ExecuteStreamResponse_StdoutChunk:
+		_, err = c.stdout.Write(out.StdoutChunk)
+	case *executorv1.ExecuteStreamResponse_StderrChunk:
+		_, err = c.stderr.Write(out.StderrChunk)
+	case *executorv1.ExecuteStreamResponse_Result:
+		_, err = fmt.Fprintf(c.stdout, "\n=== Exit Code: %d, Duration: %dms, Success: %v ===\n",
+			out.Result.ExitCode, out.Result.DurationMs, out.Result.Success)
+	}
+	return err
+}
+
+// ndjsonEvent converts a stream message to its ndjson event. Output is
+// flattened into type, stream and line; other events carry the message as
+// protojson in event, typed by its field name.
+func ndjsonEvent(msg *executorv1.ExecuteStreamResponse) *streamEvent {
+	event := &streamEvent{Timestamp: time.Now().UTC().Format(time.RFC3339Nano)}
+
+	switch out := msg.Output.(type) {
+	case *executorv1.ExecuteStreamResponse_StdoutLine:
+		event.Type, event.Stream, event.Line = "line", "stdout", &out.StdoutLine
+		return event
+	case *executorv1.ExecuteStreamResponse_StderrLine:
+		event.Type, event.Stream, event.Line = "line", "stderr", &out.StderrLine
+		return event
+	case *executorv1.ExecuteStreamResponse_StdoutChunk:
+		event.Type, event.Stream, event.Data = "chunk", "stdout", out.StdoutChunk
+		return event
+	case *executorv1.ExecuteStreamResponse_StderrChunk:
+		event.Type, event.Stream, event.Data = "chunk", "stderr", out.StderrChunk
+		return event
+	case *executorv1.ExecuteStreamResponse_Result:
+		event.ExitCode, event.Success = &out.Result.ExitCode, &out.Result.Success
+	}
+
+	m := msg.ProtoReflect()
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName("output"))
+	if field == nil {
+		event.Type = "unknown"
+		return event
+	}
+	event.Type = string(field.Name())
+	if field.Message() != nil {
+		event.Event, _ = protojson.Marshal(m.Get(field).Message().Interface())
+	}
+	return event
+}
+
+// Context implements grpc.ServerStream
+func (c *cliStream) Context() context.Context { return c.ctx }
+
+// SetHeader implements grpc.ServerStream
+func (c *cliStream) SetHeader(metadata.MD) error { return nil }
+
+// SendHeader implements grpc.ServerStream
+func (c *cliStream) SendHeader(metadata.MD) error { return nil }
+
+// SetTrailer implements grpc.ServerStream
+func (c *cliStream) SetTrailer(metadata.MD) {}
+
+// SendMsg implements grpc.ServerStream
+func (c *cliStream) SendMsg(any) error { return nil }
+
+// RecvMsg implements grpc.ServerStream
+func (c *cliStream) RecvMsg(any) error { return io.EOF }
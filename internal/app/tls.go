@@ -0,0 +1,110 @@
+package app
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/knullci/necrosword/internal/config"
+	"go.uber.org/zap"
+)
+
+// certReloadDelay lets both files of a rotation be written before reloading
+const certReloadDelay = 500 * time.Millisecond
+
+// certReloader serves the server certificate, swapping in the files'
+// contents whenever they change
+type certReloader struct {
+	certFile, keyFile string
+	logger            *zap.Logger
+	cert              atomic.Pointer[tls.Certificate]
+
+	// clientCAs verify client certificates, nil if none are requested
+	clientCAs *x509.CertPool
+}
+
+// newCertReloader loads the certificate and key, failing if they cannot be
+// used
+func newCertReloader(cfg config.TLSConfig, logger *zap.Logger) (*certReloader, error) {
+	r := &certReloader{certFile: cfg.CertFile, keyFile: cfg.KeyFile, logger: logger}
+	if _, err := r.load(); err != nil {
+		return nil, err
+	}
+	if cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		r.clientCAs = x509.NewCertPool()
+		if !r.clientCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in client CA file %s", cfg.ClientCAFile)
+		}
+	}
+	return r, nil
+}
+
+// load reads the certificate and key, swapping them in, and reports whether
+// the certificate changed
+func (r *certReloader) load() (bool, error) {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	if cert.Leaf == nil {
+		if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+			return false, fmt.Errorf("failed to parse TLS certificate: %w", err)
+		}
+	}
+
+	old := r.cert.Swap(&cert)
+	return old == nil || !bytes.Equal(old.Certificate[0], cert.Certificate[0]), nil
+}
+
+// getCertificate implements tls.Config.GetCertificate
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.cert.Load(), nil
+}
+
+// tlsConfig returns a server config serving the current certificate and
+// requiring a verified client certificate if client CAs are configured
+func (r *certReloader) tlsConfig() *tls.Config {
+	cfg := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.getCertificate,
+	}
+	if r.clientCAs != nil {
+		cfg.ClientCAs = r.clientCAs
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg
+}
+
+// watch reloads the certificate when the files change until done is
+// closed
+func (r *certReloader) watch(done <-chan struct{}) error {
+	return watchFiles([]string{r.certFile, r.keyFile}, certReloadDelay, done, func(err error) {
+		r.logger.Warn("TLS certificate watch error", zap.Error(err))
+	}, r.reload)
+}
+
+// reload swaps in the files' certificate, keeping the current one if they
+// cannot be loaded
+func (r *certReloader) reload() {
+	changed, err := r.load()
+	if err != nil {
+		r.logger.Error("failed to reload TLS certificate, keeping current one", zap.Error(err))
+		return
+	}
+	if changed {
+		leaf := r.cert.Load().Leaf
+		r.logger.Info("reloaded TLS certificate",
+			zap.String("cert_file", r.certFile),
+			zap.String("subject", leaf.Subject.String()),
+			zap.Time("not_after", leaf.NotAfter),
+		)
+	}
+}
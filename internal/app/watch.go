@@ -0,0 +1,59 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchFiles calls reload once files stopped changing for delay, until done
+// is closed. The directories are watched rather than the files, as
+// rotations and config updates often replace them or swap a symlink to
+// them. Watch errors are passed to onError.
+func watchFiles(files []string, delay time.Duration, done <-chan struct{}, onError func(error), reload func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	dirs := make(map[string]bool)
+	for _, file := range files {
+		dirs[filepath.Dir(file)] = true
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return fmt.Errorf("failed to watch %s: %w", dir, err)
+		}
+	}
+
+	go func() {
+		defer watcher.Close()
+
+		var pending <-chan time.Time
+		for {
+			select {
+			case <-done:
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if !event.Has(fsnotify.Chmod) {
+					pending = time.After(delay)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				onError(err)
+			case <-pending:
+				pending = nil
+				reload()
+			}
+		}
+	}()
+	return nil
+}
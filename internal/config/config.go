@@ -3,8 +3,14 @@ package config
 import (
 	"fmt"
 	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"slices"
 	"strings"
 
+	"github.com/knullci/necrosword/internal/signals"
 	"github.com/spf13/viper"
 )
 
@@ -19,6 +25,33 @@ type Config struct {
 type ServerConfig struct {
 	Host string `mapstructure:"host"`
 	Port int    `mapstructure:"port"`
+
+	// MethodLimits caps the encoded request size in bytes per RPC method
+	// name (e.g. execute, executepipeline), below the transport limit
+	MethodLimits map[string]int `mapstructure:"method_limits"`
+
+	// EnabledMethods lists the only RPC methods served, by method name (e.g.
+	// execute); others return Unimplemented, except Health, reflection and
+	// the gRPC health service. Empty = all methods
+	EnabledMethods []string `mapstructure:"enabled_methods"`
+
+	// MaxConnections caps open client connections, closing new ones beyond
+	// it (0 = unlimited)
+	MaxConnections int `mapstructure:"max_connections"`
+
+	// TLS serves gRPC over TLS when a certificate is configured
+	TLS TLSConfig `mapstructure:"tls"`
+}
+
+// TLSConfig holds the server certificate. Both files are watched and
+// reloaded when they change.
+type TLSConfig struct {
+	CertFile string `mapstructure:"cert_file"` // PEM certificate chain, empty = plaintext
+	KeyFile  string `mapstructure:"key_file"`  // PEM private key
+
+	// ClientCAFile requires clients to present a certificate signed by one
+	// of its CAs (PEM), empty = client certificates are not requested
+	ClientCAFile string `mapstructure:"client_ca_file"`
 }
 
 // ExecutorConfig holds process executor configuration
@@ -27,6 +60,406 @@ type ExecutorConfig struct {
 	DefaultTimeout int      `mapstructure:"default_timeout"` // in seconds
 	MaxConcurrent  int      `mapstructure:"max_concurrent"`
 	WorkspaceBase  string   `mapstructure:"workspace_base"`
+
+	// ToolWeights sets how many of the MaxConcurrent slots an execution of
+	// a tool takes, keyed by lower-case tool name (default 1)
+	ToolWeights map[string]int `mapstructure:"tool_weights"`
+
+	// AllowedToolsFile lists allowed tools one per line, replacing
+	// AllowedTools when set. It is re-read when it changes and on SIGHUP.
+	AllowedToolsFile string `mapstructure:"allowed_tools_file"`
+
+	// IDScheme selects how process and pipeline IDs are generated: uuid,
+	// ulid (sortable by creation time) or seq (a counter with a per-instance
+	// prefix)
+	IDScheme string `mapstructure:"id_scheme"`
+
+	// MaxConcurrentStreams caps open ExecuteStream and ExecutePipelineStream
+	// calls, separately from MaxConcurrent (0 = unlimited)
+	MaxConcurrentStreams int `mapstructure:"max_concurrent_streams"`
+
+	// MaxBatchSize caps the requests of one ExecuteBatch call (0 = unlimited)
+	MaxBatchSize int `mapstructure:"max_batch_size"`
+
+	// NeverLogArgsFor lists tools whose arguments are never logged, only
+	// their count and a hash, whatever the request's log_args says
+	NeverLogArgsFor []string `mapstructure:"never_log_args_for"`
+
+	// Shell is the interpreter and flags that run shell_command requests
+	Shell string `mapstructure:"shell"`
+
+	// ContainerRuntime is the command, with any leading flags, that runs
+	// steps with an image, e.g. "docker" or "podman"
+	ContainerRuntime string `mapstructure:"container_runtime"`
+
+	// ImagePrepull pulls step images when a pipeline starts
+	ImagePrepull ImagePrepullConfig `mapstructure:"image_prepull"`
+
+	// GlobalEnv are KEY=VALUE variables set for every command, hook and
+	// version check, overriding inherited variables
+	GlobalEnv []string `mapstructure:"global_env"`
+
+	// StripEnvKeys are globs for inherited environment variables removed
+	// before request env is applied, e.g. AWS_*
+	StripEnvKeys []string `mapstructure:"strip_env_keys"`
+
+	// MaxPipelineOutputBytes caps the combined stdout/stderr kept across all
+	// steps of an aggregate pipeline response (0 = unlimited)
+	MaxPipelineOutputBytes int64 `mapstructure:"max_pipeline_output_bytes"`
+
+	// MaxPipelineSteps caps the steps of a pipeline request (0 = unlimited)
+	MaxPipelineSteps int `mapstructure:"max_pipeline_steps"`
+
+	// DefaultCancelSignal is sent to a process when it is cancelled, before
+	// it is killed once CancelGracePeriod expires
+	DefaultCancelSignal string `mapstructure:"default_cancel_signal"`
+	CancelGracePeriod   int    `mapstructure:"cancel_grace_period"` // in seconds
+
+	AdmissionControl AdmissionConfig `mapstructure:"admission_control"`
+
+	// TemplateVars are static values available to args and env as ${NAME},
+	// alongside the built-in NECRO_* variables
+	TemplateVars map[string]string `mapstructure:"template_vars"`
+
+	// ScanBufferBytes is the initial line buffer per output stream and
+	// MaxLineBytes the longest line it may grow to
+	ScanBufferBytes int `mapstructure:"scan_buffer_bytes"`
+	MaxLineBytes    int `mapstructure:"max_line_bytes"`
+
+	// MaxOutputLinesPerSec caps the lines kept per output stream each second
+	// (0 = unlimited). OutputRatePolicy is drop or coalesce.
+	MaxOutputLinesPerSec int    `mapstructure:"max_output_lines_per_sec"`
+	OutputRatePolicy     string `mapstructure:"output_rate_policy"`
+
+	// OutputFilters transform every output line before it is captured or
+	// streamed, in order (strip_ansi)
+	OutputFilters []string `mapstructure:"output_filters"`
+
+	// MaxOutputBytes caps the stdout and stderr each execution keeps in its
+	// response (0 = unlimited). Streamed lines are not affected.
+	MaxOutputBytes int64 `mapstructure:"max_output_bytes"`
+
+	SpillToDisk SpillConfig `mapstructure:"spill_to_disk"`
+
+	// Isolation restricts the filesystem commands see (Linux only)
+	Isolation IsolationConfig `mapstructure:"isolation"`
+
+	// IsolatedHomeDir is where temporary HOME directories of isolate_home
+	// executions are created (defaults to the system temp dir)
+	IsolatedHomeDir string `mapstructure:"isolated_home_dir"`
+
+	// HeartbeatInterval is how often streams receive periodic events such as
+	// the remaining time before a timeout (in seconds)
+	HeartbeatInterval int `mapstructure:"heartbeat_interval"`
+
+	// SweepInterval is how often tracked processes are checked and entries
+	// for processes that no longer exist are removed (in seconds, 0 = never)
+	SweepInterval int `mapstructure:"sweep_interval"`
+
+	// WorkspaceLeaseTTL is how long a lease on a named workspace lasts
+	// unless renewed (in seconds, 0 = until released)
+	WorkspaceLeaseTTL int `mapstructure:"workspace_lease_ttl"`
+
+	PostExecHook HookConfig `mapstructure:"post_exec_hook"`
+
+	// TailBacklogLines is how many recent output lines a process keeps for
+	// new TailProcess subscribers, and TailQueueLines how many lines may be
+	// queued for a subscriber before its lines are dropped
+	TailBacklogLines int `mapstructure:"tail_backlog_lines"`
+	TailQueueLines   int `mapstructure:"tail_queue_lines"`
+
+	// MaxLifetime caps how long any process may run, whatever its timeout
+	// (in seconds, 0 = no cap)
+	MaxLifetime int `mapstructure:"max_lifetime"`
+
+	// StartRetries is how many times starting a process is retried when it
+	// fails for lack of resources, waiting StartRetryBackoffMs before the
+	// first retry and twice as long before each further one
+	StartRetries        int `mapstructure:"start_retries"`
+	StartRetryBackoffMs int `mapstructure:"start_retry_backoff_ms"`
+
+	// WaitTimeout is how long a process may keep running after closing its
+	// stdout and stderr before it is killed (in seconds, 0 = forever)
+	WaitTimeout int `mapstructure:"wait_timeout"`
+
+	// ResultRetention is how long results of detached executions are kept
+	// for GetResult (in seconds)
+	ResultRetention int `mapstructure:"result_retention"`
+
+	// FenceRetention is how long the highest fence token of a job key is
+	// kept after it was last seen (in seconds)
+	FenceRetention int `mapstructure:"fence_retention"`
+
+	// PipelineReplay keeps the events of pipeline streams for ReplayPipeline
+	PipelineReplay PipelineReplayConfig `mapstructure:"pipeline_replay"`
+
+	// Terminal allows ExecuteTerminal sessions
+	Terminal TerminalConfig `mapstructure:"terminal"`
+
+	// LogForward ships every output line to a log aggregator
+	LogForward LogForwardConfig `mapstructure:"log_forward"`
+
+	// LogStream controls the StreamLogs RPC
+	LogStream LogStreamConfig `mapstructure:"log_stream"`
+
+	// TenantIsolation confines each caller to its workspace subtree
+	TenantIsolation TenantIsolationConfig `mapstructure:"tenant_isolation"`
+
+	// Secrets selects the provider resolving ${secret:<ref>} references
+	Secrets SecretsConfig `mapstructure:"secrets"`
+
+	// Download controls the DownloadFile RPC
+	Download DownloadConfig `mapstructure:"download"`
+
+	// EnvSnapshot controls the EnvSnapshotEvent of emit_env_snapshot requests
+	EnvSnapshot EnvSnapshotConfig `mapstructure:"env_snapshot"`
+
+	// InjectContextEnv passes execution identifiers to every child process
+	InjectContextEnv ContextEnvConfig `mapstructure:"inject_context_env"`
+
+	// StepLibrary holds shared pipeline steps, referenced by BuildStep.use
+	StepLibrary map[string]LibraryStepConfig `mapstructure:"step_library"`
+
+	// ToolDefaultArgs adds arguments to every invocation of a tool
+	ToolDefaultArgs map[string]ToolArgsConfig `mapstructure:"tool_default_args"`
+
+	// ToolVersions pins tools to an expected version, verified before first use
+	ToolVersions map[string]ToolVersionConfig `mapstructure:"tool_versions"`
+
+	// ToolHealthchecks are probes showing whether a tool is usable, run by
+	// the CheckTool RPC and, with HealthcheckOnStartup, when the server starts
+	ToolHealthchecks     map[string]ToolHealthcheckConfig `mapstructure:"tool_healthchecks"`
+	HealthcheckOnStartup bool                             `mapstructure:"healthcheck_on_startup"`
+}
+
+// LibraryStepConfig is a pipeline step pipelines can reference by name
+type LibraryStepConfig struct {
+	Tool            string   `mapstructure:"tool"`
+	Args            []string `mapstructure:"args"`
+	ShellCommand    string   `mapstructure:"shell_command"`
+	WorkDir         string   `mapstructure:"work_dir"`
+	Env             []string `mapstructure:"env"`
+	TimeoutSeconds  int      `mapstructure:"timeout_seconds"`
+	Image           string   `mapstructure:"image"`
+	MaxRetries      int      `mapstructure:"max_retries"`
+	ContinueOnError bool     `mapstructure:"continue_on_error"`
+}
+
+// ToolVersionConfig describes how to check a tool's installed version
+type ToolVersionConfig struct {
+	Args    []string `mapstructure:"args"`    // arguments that make the tool print its version
+	Pattern string   `mapstructure:"pattern"` // regex the version output must match
+}
+
+// ToolHealthcheckConfig is a tool's health probe
+type ToolHealthcheckConfig struct {
+	Args           []string `mapstructure:"args"`            // arguments the tool is run with, e.g. ["info"]
+	TimeoutSeconds int      `mapstructure:"timeout_seconds"` // 0 = 10 seconds
+}
+
+// ToolArgsConfig holds default arguments for a tool
+type ToolArgsConfig struct {
+	Args     []string `mapstructure:"args"`
+	Position string   `mapstructure:"position"` // "prepend" (default) or "append"
+}
+
+// HookConfig describes a command run after every execution
+type HookConfig struct {
+	Tool        string   `mapstructure:"tool"` // empty disables the hook
+	Args        []string `mapstructure:"args"`
+	Timeout     int      `mapstructure:"timeout"`       // in seconds
+	FailOnError bool     `mapstructure:"fail_on_error"` // mark the execution failed if the hook fails
+}
+
+// LogForwardConfig describes the log aggregator output lines are shipped to
+type LogForwardConfig struct {
+	Type            string            `mapstructure:"type"` // loki, fluentd or http, empty = disabled
+	Endpoint        string            `mapstructure:"endpoint"`
+	Labels          map[string]string `mapstructure:"labels"`
+	BatchLines      int               `mapstructure:"batch_lines"`
+	FlushIntervalMs int               `mapstructure:"flush_interval_ms"`
+	QueueLines      int               `mapstructure:"queue_lines"`
+	MaxRetries      int               `mapstructure:"max_retries"`
+	TimeoutSeconds  int               `mapstructure:"timeout_seconds"`
+}
+
+// PipelineReplayConfig bounds the event logs kept for ReplayPipeline
+type PipelineReplayConfig struct {
+	Enabled      bool `mapstructure:"enabled"`
+	MaxPipelines int  `mapstructure:"max_pipelines"` // finished pipelines kept, oldest evicted first
+	MaxEvents    int  `mapstructure:"max_events"`    // events kept per pipeline
+	Retention    int  `mapstructure:"retention"`     // in seconds after a pipeline finishes
+}
+
+// TerminalConfig controls interactive terminal sessions
+type TerminalConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// LogStreamConfig controls streaming the server's logs to clients
+type LogStreamConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	Token        string `mapstructure:"token"`         // bearer token clients must send, empty = none
+	QueueEntries int    `mapstructure:"queue_entries"` // entries queued per client before dropping
+}
+
+// IsolationConfig confines commands to a prepared root filesystem
+type IsolationConfig struct {
+	Mode       string   `mapstructure:"mode"`        // chroot or namespace, empty = disabled
+	Root       string   `mapstructure:"root"`        // absolute path of the prepared root
+	BindMounts []string `mapstructure:"bind_mounts"` // namespace: src[:dst][:ro] mounted into root
+}
+
+// validate checks the isolation mode can be used on this platform
+func (c IsolationConfig) validate() error {
+	switch c.Mode {
+	case "":
+		return nil
+	case "chroot", "namespace":
+	default:
+		return fmt.Errorf("executor.isolation.mode must be chroot or namespace, got '%s'", c.Mode)
+	}
+	if runtime.GOOS != "linux" {
+		return fmt.Errorf("executor.isolation is only supported on Linux")
+	}
+	if !filepath.IsAbs(c.Root) {
+		return fmt.Errorf("executor.isolation.root must be an absolute path")
+	}
+	if c.Mode != "namespace" && len(c.BindMounts) > 0 {
+		return fmt.Errorf("executor.isolation.bind_mounts requires the namespace mode")
+	}
+	for _, spec := range c.BindMounts {
+		if _, _, _, err := ParseBindMount(spec); err != nil {
+			return fmt.Errorf("executor.isolation.bind_mounts: %w", err)
+		}
+	}
+	return nil
+}
+
+// ParseBindMount splits a src[:dst][:ro] bind mount, dst defaulting to src
+func ParseBindMount(spec string) (src, dst string, readOnly bool, err error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) > 1 && parts[len(parts)-1] == "ro" {
+		readOnly = true
+		parts = parts[:len(parts)-1]
+	}
+	switch len(parts) {
+	case 1:
+		src, dst = parts[0], parts[0]
+	case 2:
+		src, dst = parts[0], parts[1]
+	default:
+		return "", "", false, fmt.Errorf("bind mount '%s' must be src[:dst][:ro]", spec)
+	}
+	if !filepath.IsAbs(src) || !filepath.IsAbs(dst) {
+		return "", "", false, fmt.Errorf("bind mount '%s' must use absolute paths", spec)
+	}
+	return filepath.Clean(src), filepath.Clean(dst), readOnly, nil
+}
+
+// ImagePrepullConfig controls pulling a pipeline's step images before its
+// first step
+type ImagePrepullConfig struct {
+	Enabled        bool `mapstructure:"enabled"`
+	TimeoutSeconds int  `mapstructure:"timeout_seconds"` // for all pulls together
+}
+
+// TenantIsolationConfig confines requests to WorkspaceBase/<tenant>, the
+// tenant being the one the caller's credentials belong to
+type TenantIsolationConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Source  string `mapstructure:"source"` // token or certificate
+
+	// Tokens maps the bearer tokens of the token source to their tenants
+	Tokens []TenantToken `mapstructure:"tokens"`
+}
+
+// TenantToken is a bearer token authenticating a tenant
+type TenantToken struct {
+	Token  string `mapstructure:"token"`
+	Tenant string `mapstructure:"tenant"`
+}
+
+// TenantPattern restricts tenant IDs to one safe path element
+var TenantPattern = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9._-]*$`)
+
+// validate checks the tenants can be authenticated
+func (c TenantIsolationConfig) validate(tls TLSConfig) error {
+	if !c.Enabled {
+		return nil
+	}
+	switch c.Source {
+	case "token":
+		if len(c.Tokens) == 0 {
+			return fmt.Errorf("executor.tenant_isolation.tokens is required with the token source")
+		}
+		seen := make(map[string]bool, len(c.Tokens))
+		for i, token := range c.Tokens {
+			if token.Token == "" || seen[token.Token] {
+				return fmt.Errorf("executor.tenant_isolation.tokens[%d]: token must be set and unique", i)
+			}
+			if !TenantPattern.MatchString(token.Tenant) {
+				return fmt.Errorf("executor.tenant_isolation.tokens[%d]: invalid tenant '%s'", i, token.Tenant)
+			}
+			seen[token.Token] = true
+		}
+	case "certificate":
+		if tls.ClientCAFile == "" {
+			return fmt.Errorf("executor.tenant_isolation with the certificate source requires server.tls.client_ca_file")
+		}
+	default:
+		return fmt.Errorf("executor.tenant_isolation.source must be 'token' or 'certificate', got '%s'", c.Source)
+	}
+	return nil
+}
+
+// SecretsConfig selects where ${secret:<ref>} references are fetched from
+type SecretsConfig struct {
+	Provider  string `mapstructure:"provider"`   // env or file, empty = secrets are not resolved
+	EnvPrefix string `mapstructure:"env_prefix"` // env: prefix of the variable names
+	Dir       string `mapstructure:"dir"`        // file: directory holding one file per secret
+}
+
+// DownloadConfig controls streaming files to clients
+type DownloadConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	Token      string `mapstructure:"token"`       // bearer token clients must send, empty = none
+	MaxBytes   int64  `mapstructure:"max_bytes"`   // largest file served
+	ChunkBytes int    `mapstructure:"chunk_bytes"` // size of each streamed chunk
+}
+
+// EnvSnapshotConfig controls the environment snapshots sent to stream clients
+type EnvSnapshotConfig struct {
+	Enabled    bool     `mapstructure:"enabled"`
+	RedactKeys []string `mapstructure:"redact_keys"` // globs for keys whose values are hidden, case-insensitive
+}
+
+// ContextEnvConfig names the environment variables carrying execution
+// identifiers. An empty name leaves that variable out.
+type ContextEnvConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	ProcessID  string `mapstructure:"process_id"`
+	PipelineID string `mapstructure:"pipeline_id"`
+	StepName   string `mapstructure:"step_name"`
+}
+
+// AdmissionConfig holds thresholds for refusing new executions while the
+// host is under pressure
+type AdmissionConfig struct {
+	Enabled              bool    `mapstructure:"enabled"`
+	MaxLoadPerCPU        float64 `mapstructure:"max_load_per_cpu"`        // 1-minute load average divided by CPU count
+	MinAvailableMemoryMB int     `mapstructure:"min_available_memory_mb"` // MemAvailable floor
+	Mode                 string  `mapstructure:"mode"`                    // reject or delay
+	MaxDelay             int     `mapstructure:"max_delay"`               // in seconds, for delay mode
+}
+
+// SpillConfig controls writing captured output beyond a threshold to files
+// instead of memory
+type SpillConfig struct {
+	Enabled        bool   `mapstructure:"enabled"`
+	ThresholdBytes int64  `mapstructure:"threshold_bytes"` // output kept in memory per stream
+	Dir            string `mapstructure:"dir"`             // defaults to <workspace_base>/.spill
+	Retention      int    `mapstructure:"retention"`       // in seconds, before spill files are removed
 }
 
 // LoggingConfig holds logging configuration
@@ -35,26 +468,106 @@ type LoggingConfig struct {
 	Format string `mapstructure:"format"` // json or console
 }
 
-// Load reads configuration from file and environment
-func Load() (*Config, error) {
+// configPaths are searched in order for a config file
+var configPaths = []string{".", "./config", "/etc/necrosword"}
+
+// configExtensions are tried in order within each path, so YAML wins when
+// several formats are present
+var configExtensions = []string{"yaml", "yml", "toml", "json"}
+
+// Load reads configuration from file and environment. The file format is
+// detected from its extension unless configType (yaml, toml, json) is given.
+func Load(configType string) (*Config, error) {
 	v := viper.New()
 
 	// Set defaults
 	v.SetDefault("server.host", "0.0.0.0")
 	v.SetDefault("server.port", 8081)
+	v.SetDefault("server.max_connections", 0)
+	v.SetDefault("server.enabled_methods", []string{})
 	v.SetDefault("executor.allowed_tools", []string{"git", "npm", "mvn", "docker", "kubectl", "go", "make", "mkdir"})
 	v.SetDefault("executor.default_timeout", 3600) // 1 hour
 	v.SetDefault("executor.max_concurrent", 10)
+	v.SetDefault("executor.max_concurrent_streams", 0)
+	v.SetDefault("executor.max_batch_size", 100)
+	v.SetDefault("executor.id_scheme", "uuid")
 	v.SetDefault("executor.workspace_base", "workspace")
+	v.SetDefault("executor.max_pipeline_output_bytes", 40*1024*1024) // stay below the 50MB gRPC message limit
+	v.SetDefault("executor.max_pipeline_steps", 500)
+	v.SetDefault("executor.shell", defaultShell())
+	v.SetDefault("executor.container_runtime", "docker")
+	v.SetDefault("executor.image_prepull.enabled", false)
+	v.SetDefault("executor.image_prepull.timeout_seconds", 600)
+	v.SetDefault("executor.default_cancel_signal", "SIGTERM")
+	v.SetDefault("executor.cancel_grace_period", 10)
+	v.SetDefault("executor.admission_control.enabled", false)
+	v.SetDefault("executor.admission_control.max_load_per_cpu", 4.0)
+	v.SetDefault("executor.admission_control.min_available_memory_mb", 256)
+	v.SetDefault("executor.admission_control.mode", "reject")
+	v.SetDefault("executor.admission_control.max_delay", 30)
+	v.SetDefault("executor.scan_buffer_bytes", 64*1024)
+	v.SetDefault("executor.max_line_bytes", 1024*1024)
+	v.SetDefault("executor.max_output_bytes", 0)
+	v.SetDefault("executor.spill_to_disk.enabled", false)
+	v.SetDefault("executor.spill_to_disk.threshold_bytes", 1024*1024)
+	v.SetDefault("executor.spill_to_disk.retention", 3600)
+	v.SetDefault("executor.isolation.mode", "")
+	v.SetDefault("executor.isolated_home_dir", "")
+	v.SetDefault("executor.heartbeat_interval", 15)
+	v.SetDefault("executor.sweep_interval", 60)
+	v.SetDefault("executor.workspace_lease_ttl", 86400)
+	v.SetDefault("executor.result_retention", 3600)
+	v.SetDefault("executor.fence_retention", 86400)
+	v.SetDefault("executor.pipeline_replay.enabled", false)
+	v.SetDefault("executor.pipeline_replay.max_pipelines", 100)
+	v.SetDefault("executor.pipeline_replay.max_events", 100000)
+	v.SetDefault("executor.pipeline_replay.retention", 3600)
+	v.SetDefault("executor.terminal.enabled", false)
+	v.SetDefault("executor.wait_timeout", 0)
+	v.SetDefault("executor.max_lifetime", 0)
+	v.SetDefault("executor.start_retries", 3)
+	v.SetDefault("executor.start_retry_backoff_ms", 100)
+	v.SetDefault("executor.tail_backlog_lines", 1000)
+	v.SetDefault("executor.tail_queue_lines", 1000)
+	v.SetDefault("executor.post_exec_hook.timeout", 60)
+	v.SetDefault("executor.post_exec_hook.fail_on_error", false)
+	v.SetDefault("executor.log_forward.type", "")
+	v.SetDefault("executor.log_forward.batch_lines", 500)
+	v.SetDefault("executor.log_forward.flush_interval_ms", 1000)
+	v.SetDefault("executor.log_forward.queue_lines", 10000)
+	v.SetDefault("executor.log_forward.max_retries", 3)
+	v.SetDefault("executor.log_forward.timeout_seconds", 10)
+	v.SetDefault("executor.log_stream.enabled", false)
+	v.SetDefault("executor.log_stream.queue_entries", 1000)
+	v.SetDefault("executor.tenant_isolation.enabled", false)
+	v.SetDefault("executor.tenant_isolation.source", "token")
+	v.SetDefault("executor.secrets.env_prefix", "NECROSWORD_SECRET_")
+	v.SetDefault("executor.healthcheck_on_startup", false)
+	v.SetDefault("executor.max_output_lines_per_sec", 0)
+	v.SetDefault("executor.output_rate_policy", "drop")
+	v.SetDefault("executor.output_filters", []string{})
+	v.SetDefault("executor.download.enabled", false)
+	v.SetDefault("executor.download.max_bytes", 1<<30)
+	v.SetDefault("executor.download.chunk_bytes", 64*1024)
+	v.SetDefault("executor.env_snapshot.enabled", true)
+	v.SetDefault("executor.env_snapshot.redact_keys", []string{"*TOKEN*", "*SECRET*", "*PASSWORD*", "*PASSWD*", "*KEY*", "*CREDENTIAL*", "*AUTH*"})
+	v.SetDefault("executor.inject_context_env.enabled", true)
+	v.SetDefault("executor.inject_context_env.process_id", "NECROSWORD_PROCESS_ID")
+	v.SetDefault("executor.inject_context_env.pipeline_id", "NECROSWORD_PIPELINE_ID")
+	v.SetDefault("executor.inject_context_env.step_name", "NECROSWORD_STEP_NAME")
 	v.SetDefault("logging.level", "info")
 	v.SetDefault("logging.format", "json")
 
 	// Config file settings
-	v.SetConfigName("config")
-	v.SetConfigType("yaml")
-	v.AddConfigPath(".")
-	v.AddConfigPath("./config")
-	v.AddConfigPath("/etc/necrosword")
+	if configType != "" {
+		v.SetConfigName("config")
+		v.SetConfigType(configType)
+		for _, path := range configPaths {
+			v.AddConfigPath(path)
+		}
+	} else if file := findConfigFile(); file != "" {
+		v.SetConfigFile(file)
+	}
 
 	// Environment variables with NECROSWORD prefix
 	v.SetEnvPrefix("NECROSWORD")
@@ -62,11 +575,13 @@ func Load() (*Config, error) {
 	v.AutomaticEnv()
 
 	// Try to read config file (optional)
-	if err := v.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
-			return nil, fmt.Errorf("error reading config file: %w", err)
+	if configType != "" || v.ConfigFileUsed() != "" {
+		if err := v.ReadInConfig(); err != nil {
+			if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+				return nil, fmt.Errorf("error reading config file: %w", err)
+			}
+			// Config file not found, using defaults and env vars
 		}
-		// Config file not found, using defaults and env vars
 	}
 
 	var cfg Config
@@ -84,20 +599,240 @@ func Load() (*Config, error) {
 		}
 	}
 
+	if file := cfg.Executor.AllowedToolsFile; file != "" {
+		tools, err := ReadAllowedToolsFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("executor.allowed_tools_file: %w", err)
+		}
+		cfg.Executor.AllowedTools = tools
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
 	return &cfg, nil
 }
 
+// OutputFilterNames are the known executor.output_filters
+var OutputFilterNames = []string{"strip_ansi"}
+
+// MinScanBufferBytes is the smallest accepted executor.scan_buffer_bytes
+const MinScanBufferBytes = 4 * 1024
+
+// defaultShell returns the platform shell used for shell_command requests
+func defaultShell() string {
+	if runtime.GOOS == "windows" {
+		return "cmd /C"
+	}
+	return "sh -c"
+}
+
+// findConfigFile returns the first config file found in configPaths, or ""
+func findConfigFile() string {
+	for _, path := range configPaths {
+		for _, ext := range configExtensions {
+			file := filepath.Join(path, "config."+ext)
+			if info, err := os.Stat(file); err == nil && !info.IsDir() {
+				return file
+			}
+		}
+	}
+	return ""
+}
+
+// Validate checks the configuration for values that cannot be used
+func (c *Config) Validate() error {
+	if tls := c.Server.TLS; (tls.CertFile == "") != (tls.KeyFile == "") {
+		return fmt.Errorf("server.tls.cert_file and key_file must be set together")
+	}
+	if tls := c.Server.TLS; tls.ClientCAFile != "" && tls.CertFile == "" {
+		return fmt.Errorf("server.tls.client_ca_file requires cert_file and key_file")
+	}
+	for method, limit := range c.Server.MethodLimits {
+		if limit <= 0 {
+			return fmt.Errorf("server.method_limits.%s must be positive", method)
+		}
+	}
+	if c.Server.MaxConnections < 0 {
+		return fmt.Errorf("server.max_connections must not be negative")
+	}
+	if _, err := NewToolMatcher(c.Executor.AllowedTools); err != nil {
+		return fmt.Errorf("executor.allowed_tools: %w", err)
+	}
+	if scheme := c.Executor.IDScheme; scheme != "uuid" && scheme != "ulid" && scheme != "seq" {
+		return fmt.Errorf("executor.id_scheme must be 'uuid', 'ulid' or 'seq', got '%s'", scheme)
+	}
+	if _, err := signals.Parse(c.Executor.DefaultCancelSignal); err != nil {
+		return fmt.Errorf("executor.default_cancel_signal: %w", err)
+	}
+	if c.Executor.CancelGracePeriod < 0 {
+		return fmt.Errorf("executor.cancel_grace_period must not be negative")
+	}
+	if mode := c.Executor.AdmissionControl.Mode; mode != "reject" && mode != "delay" {
+		return fmt.Errorf("executor.admission_control.mode must be 'reject' or 'delay', got '%s'", mode)
+	}
+	if c.Executor.ScanBufferBytes < MinScanBufferBytes {
+		return fmt.Errorf("executor.scan_buffer_bytes must be at least %d", MinScanBufferBytes)
+	}
+	if c.Executor.MaxOutputLinesPerSec < 0 {
+		return fmt.Errorf("executor.max_output_lines_per_sec must not be negative")
+	}
+	for _, filter := range c.Executor.OutputFilters {
+		if !slices.Contains(OutputFilterNames, filter) {
+			return fmt.Errorf("executor.output_filters: unknown filter '%s' (want one of %v)", filter, OutputFilterNames)
+		}
+	}
+	if p := c.Executor.OutputRatePolicy; p != "" && p != "drop" && p != "coalesce" {
+		return fmt.Errorf("executor.output_rate_policy must be drop or coalesce, got '%s'", p)
+	}
+	if c.Executor.MaxLineBytes < c.Executor.ScanBufferBytes {
+		return fmt.Errorf("executor.max_line_bytes must be at least executor.scan_buffer_bytes (%d)", c.Executor.ScanBufferBytes)
+	}
+	for _, pattern := range c.Executor.StripEnvKeys {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("executor.strip_env_keys: invalid pattern '%s': %w", pattern, err)
+		}
+	}
+	if c.Executor.MaxConcurrentStreams < 0 {
+		return fmt.Errorf("executor.max_concurrent_streams must not be negative")
+	}
+	if c.Executor.MaxBatchSize < 0 {
+		return fmt.Errorf("executor.max_batch_size must not be negative")
+	}
+	if c.Executor.MaxOutputBytes < 0 {
+		return fmt.Errorf("executor.max_output_bytes must not be negative")
+	}
+	if spill := c.Executor.SpillToDisk; spill.Enabled && (spill.ThresholdBytes <= 0 || spill.Retention <= 0) {
+		return fmt.Errorf("executor.spill_to_disk.threshold_bytes and retention must be positive")
+	}
+	if c.Executor.HeartbeatInterval <= 0 {
+		return fmt.Errorf("executor.heartbeat_interval must be positive")
+	}
+	if c.Executor.SweepInterval < 0 {
+		return fmt.Errorf("executor.sweep_interval must not be negative")
+	}
+	if c.Executor.WorkspaceLeaseTTL < 0 {
+		return fmt.Errorf("executor.workspace_lease_ttl must not be negative")
+	}
+	if c.Executor.TailBacklogLines < 0 {
+		return fmt.Errorf("executor.tail_backlog_lines must not be negative")
+	}
+	if c.Executor.TailQueueLines <= 0 {
+		return fmt.Errorf("executor.tail_queue_lines must be positive")
+	}
+	if c.Executor.MaxLifetime < 0 {
+		return fmt.Errorf("executor.max_lifetime must not be negative")
+	}
+	if c.Executor.StartRetries < 0 || c.Executor.StartRetryBackoffMs < 0 {
+		return fmt.Errorf("executor.start_retries and executor.start_retry_backoff_ms must not be negative")
+	}
+	if c.Executor.WaitTimeout < 0 {
+		return fmt.Errorf("executor.wait_timeout must not be negative")
+	}
+	if fwd := c.Executor.LogForward; fwd.Type != "" {
+		if fwd.Type != "loki" && fwd.Type != "fluentd" && fwd.Type != "http" {
+			return fmt.Errorf("executor.log_forward.type must be 'loki', 'fluentd' or 'http', got '%s'", fwd.Type)
+		}
+		if fwd.Endpoint == "" {
+			return fmt.Errorf("executor.log_forward.endpoint is required")
+		}
+		if fwd.BatchLines <= 0 || fwd.FlushIntervalMs <= 0 || fwd.QueueLines <= 0 || fwd.TimeoutSeconds <= 0 {
+			return fmt.Errorf("executor.log_forward batch_lines, flush_interval_ms, queue_lines and timeout_seconds must be positive")
+		}
+		if fwd.MaxRetries < 0 {
+			return fmt.Errorf("executor.log_forward.max_retries must not be negative")
+		}
+	}
+	if c.Executor.LogStream.Enabled && c.Executor.LogStream.QueueEntries <= 0 {
+		return fmt.Errorf("executor.log_stream.queue_entries must be positive")
+	}
+	if err := c.Executor.TenantIsolation.validate(c.Server.TLS); err != nil {
+		return err
+	}
+	for tool, weight := range c.Executor.ToolWeights {
+		if weight <= 0 {
+			return fmt.Errorf("executor.tool_weights.%s must be positive", tool)
+		}
+	}
+	if c.Executor.ImagePrepull.Enabled && c.Executor.ImagePrepull.TimeoutSeconds <= 0 {
+		return fmt.Errorf("executor.image_prepull.timeout_seconds must be positive")
+	}
+	if replay := c.Executor.PipelineReplay; replay.Enabled && (replay.MaxPipelines <= 0 || replay.MaxEvents <= 0 || replay.Retention <= 0) {
+		return fmt.Errorf("executor.pipeline_replay.max_pipelines, max_events and retention must be positive")
+	}
+	if c.Executor.Download.Enabled && (c.Executor.Download.MaxBytes <= 0 || c.Executor.Download.ChunkBytes <= 0) {
+		return fmt.Errorf("executor.download.max_bytes and chunk_bytes must be positive")
+	}
+	switch c.Executor.Secrets.Provider {
+	case "", "env":
+	case "file":
+		if c.Executor.Secrets.Dir == "" {
+			return fmt.Errorf("executor.secrets.dir is required for the file provider")
+		}
+	default:
+		return fmt.Errorf("executor.secrets.provider must be env or file, got '%s'", c.Executor.Secrets.Provider)
+	}
+	if err := c.Executor.Isolation.validate(); err != nil {
+		return err
+	}
+	if c.Executor.MaxPipelineSteps < 0 {
+		return fmt.Errorf("executor.max_pipeline_steps must not be negative")
+	}
+	if c.Executor.FenceRetention <= 0 {
+		return fmt.Errorf("executor.fence_retention must be positive")
+	}
+	if c.Executor.ResultRetention <= 0 {
+		return fmt.Errorf("executor.result_retention must be positive")
+	}
+	if c.Executor.PostExecHook.Tool != "" && c.Executor.PostExecHook.Timeout <= 0 {
+		return fmt.Errorf("executor.post_exec_hook.timeout must be positive")
+	}
+	for _, kv := range c.Executor.GlobalEnv {
+		if key, _, ok := strings.Cut(kv, "="); !ok || key == "" {
+			return fmt.Errorf("executor.global_env entry '%s' must be KEY=VALUE", kv)
+		}
+	}
+	for name, step := range c.Executor.StepLibrary {
+		if (step.Tool == "") == (step.ShellCommand == "") {
+			return fmt.Errorf("executor.step_library.%s needs exactly one of tool and shell_command", name)
+		}
+		if step.TimeoutSeconds < 0 || step.MaxRetries < 0 {
+			return fmt.Errorf("executor.step_library.%s: timeout_seconds and max_retries must not be negative", name)
+		}
+	}
+	for tool, defaults := range c.Executor.ToolDefaultArgs {
+		if p := defaults.Position; p != "" && p != "prepend" && p != "append" {
+			return fmt.Errorf("executor.tool_default_args.%s.position must be 'prepend' or 'append', got '%s'", tool, p)
+		}
+	}
+	for tool, check := range c.Executor.ToolHealthchecks {
+		if check.TimeoutSeconds < 0 {
+			return fmt.Errorf("executor.tool_healthchecks.%s.timeout_seconds must not be negative", tool)
+		}
+	}
+	for tool, pin := range c.Executor.ToolVersions {
+		if pin.Pattern == "" {
+			return fmt.Errorf("executor.tool_versions.%s.pattern is required", tool)
+		}
+		if _, err := regexp.Compile(pin.Pattern); err != nil {
+			return fmt.Errorf("executor.tool_versions.%s.pattern: %w", tool, err)
+		}
+	}
+	return nil
+}
+
 // Address returns the server address string
 func (c *ServerConfig) Address() string {
 	return fmt.Sprintf("%s:%d", c.Host, c.Port)
 }
 
-// IsToolAllowed checks if a tool is in the allowed list
+// IsToolAllowed checks if a tool is in the allowed list. Invalid patterns
+// never match; Validate rejects them at load time.
 func (c *ExecutorConfig) IsToolAllowed(tool string) bool {
-	for _, t := range c.AllowedTools {
-		if strings.EqualFold(t, tool) {
-			return true
-		}
+	m, err := NewToolMatcher(c.AllowedTools)
+	if err != nil {
+		return false
 	}
-	return false
+	return m.Match(tool)
 }
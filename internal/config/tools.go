@@ -0,0 +1,124 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Prefixes marking allowed_tools entries as patterns rather than exact names
+const (
+	regexToolPrefix = "re:"
+	globToolPrefix  = "glob:"
+)
+
+// IsToolPattern reports whether an allowed_tools entry is a pattern
+func IsToolPattern(entry string) bool {
+	return strings.HasPrefix(entry, regexToolPrefix) || strings.HasPrefix(entry, globToolPrefix)
+}
+
+// ReadAllowedToolsFile reads allowed_tools entries from file, one per line.
+// Blank lines and lines starting with '#' are ignored.
+func ReadAllowedToolsFile(file string) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	tools := []string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		tools = append(tools, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return tools, nil
+}
+
+// ToolMatcher matches tools against the allowed_tools entries. Plain entries
+// match the tool name case-insensitively; "re:" entries are regular
+// expressions and "glob:" entries are globs, both matched against the whole
+// tool name or its resolved path.
+type ToolMatcher struct {
+	entries  []string
+	names    []string
+	patterns []func(string) bool
+}
+
+// NewToolMatcher compiles allowed_tools entries
+func NewToolMatcher(entries []string) (*ToolMatcher, error) {
+	m := &ToolMatcher{entries: entries}
+
+	for _, entry := range entries {
+		switch {
+		case strings.HasPrefix(entry, regexToolPrefix):
+			re, err := regexp.Compile("^(?:" + strings.TrimPrefix(entry, regexToolPrefix) + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed tool pattern '%s': %w", entry, err)
+			}
+			m.patterns = append(m.patterns, re.MatchString)
+
+		case strings.HasPrefix(entry, globToolPrefix):
+			glob := strings.TrimPrefix(entry, globToolPrefix)
+			if _, err := filepath.Match(glob, ""); err != nil {
+				return nil, fmt.Errorf("invalid allowed tool pattern '%s': %w", entry, err)
+			}
+			m.patterns = append(m.patterns, func(s string) bool {
+				ok, _ := filepath.Match(glob, s)
+				return ok
+			})
+
+		default:
+			m.names = append(m.names, entry)
+		}
+	}
+
+	return m, nil
+}
+
+// Entries returns the allowed_tools entries the matcher was built from
+func (m *ToolMatcher) Entries() []string {
+	return m.entries
+}
+
+// Match reports whether tool is allowed
+func (m *ToolMatcher) Match(tool string) bool {
+	for _, name := range m.names {
+		if strings.EqualFold(name, tool) {
+			return true
+		}
+	}
+
+	if len(m.patterns) == 0 || tool == "" {
+		return false
+	}
+
+	candidates := []string{tool}
+	if resolved, err := exec.LookPath(tool); err == nil {
+		if abs, err := filepath.Abs(resolved); err == nil {
+			resolved = abs
+		}
+		if resolved != tool {
+			candidates = append(candidates, resolved)
+		}
+	}
+
+	for _, match := range m.patterns {
+		for _, candidate := range candidates {
+			if match(candidate) {
+				return true
+			}
+		}
+	}
+	return false
+}
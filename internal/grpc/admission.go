@@ -0,0 +1,166 @@
+package grpc
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// admissionPollInterval is how often delayed executions re-check system load
+const admissionPollInterval = time.Second
+
+// acquireSlot waits for tool's weight in concurrency slots, after checking
+// admission control. The returned function releases them.
+func (s *ExecutorServer) acquireSlot(ctx context.Context, tool string) (func(), error) {
+	if err := s.admit(ctx); err != nil {
+		return nil, err
+	}
+
+	weight := s.toolWeight(tool)
+	if err := s.slots.Acquire(ctx, weight); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	return func() { s.slots.Release(weight) }, nil
+}
+
+// toolWeight returns the slots an execution of tool takes from
+// max_concurrent: its executor.tool_weights entry, 1 by default. Weights
+// above max_concurrent are capped so the tool can still run alone.
+func (s *ExecutorServer) toolWeight(tool string) int64 {
+	weight, ok := s.config.ToolWeights[strings.ToLower(filepath.Base(tool))]
+	if !ok || weight <= 0 {
+		return 1
+	}
+	return min(int64(weight), s.maxConcurrent)
+}
+
+// acquireStream claims one of the executor.max_concurrent_streams slots,
+// failing immediately when none is free. The returned function releases it.
+func (s *ExecutorServer) acquireStream() (func(), error) {
+	if s.streams == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case s.streams <- struct{}{}:
+		return func() { <-s.streams }, nil
+	default:
+		return nil, status.Errorf(codes.ResourceExhausted, "too many concurrent streams (max %d)", cap(s.streams))
+	}
+}
+
+// admit rejects or delays a new execution while the system is under pressure
+func (s *ExecutorServer) admit(ctx context.Context) error {
+	ac := s.config.AdmissionControl
+	if !ac.Enabled {
+		return nil
+	}
+
+	reason := s.checkPressure()
+	if reason == "" {
+		return nil
+	}
+
+	if ac.Mode != "delay" {
+		return status.Errorf(codes.ResourceExhausted, "execution rejected, system under pressure: %s", reason)
+	}
+
+	deadline := time.NewTimer(time.Duration(ac.MaxDelay) * time.Second)
+	defer deadline.Stop()
+	ticker := time.NewTicker(admissionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-deadline.C:
+			return status.Errorf(codes.ResourceExhausted, "execution rejected, system still under pressure after %ds: %s", ac.MaxDelay, reason)
+		case <-ticker.C:
+			if reason = s.checkPressure(); reason == "" {
+				return nil
+			}
+		}
+	}
+}
+
+// checkPressure compares system load and memory to the admission thresholds,
+// records the throttle state and returns why executions should be held back
+// ("" when they can proceed)
+func (s *ExecutorServer) checkPressure() string {
+	ac := s.config.AdmissionControl
+	if !ac.Enabled {
+		return ""
+	}
+
+	var reason string
+	if ac.MaxLoadPerCPU > 0 {
+		if load, err := readLoadAverage(); err == nil {
+			perCPU := load / float64(runtime.NumCPU())
+			if perCPU > ac.MaxLoadPerCPU {
+				reason = fmt.Sprintf("load average per CPU %.2f exceeds %.2f", perCPU, ac.MaxLoadPerCPU)
+			}
+		}
+	}
+	if reason == "" && ac.MinAvailableMemoryMB > 0 {
+		if availableMB, err := readAvailableMemoryMB(); err == nil && availableMB < int64(ac.MinAvailableMemoryMB) {
+			reason = fmt.Sprintf("available memory %dMB below %dMB", availableMB, ac.MinAvailableMemoryMB)
+		}
+	}
+
+	if previous := s.throttleReason.Swap(reason); previous != reason {
+		if reason != "" {
+			s.logger.Warn("admission control throttling new executions", zap.String("reason", reason))
+		} else {
+			s.logger.Info("admission control no longer throttling")
+		}
+	}
+
+	return reason
+}
+
+// readLoadAverage returns the 1-minute load average from /proc/loadavg
+func readLoadAverage() (float64, error) {
+	data, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return 0, err
+	}
+
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("unexpected /proc/loadavg format")
+	}
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+// readAvailableMemoryMB returns MemAvailable from /proc/meminfo in megabytes
+func readAvailableMemoryMB() (int64, error) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "MemAvailable:" {
+			kb, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return 0, err
+			}
+			return kb / 1024, nil
+		}
+	}
+	return 0, fmt.Errorf("MemAvailable not found in /proc/meminfo")
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// planArtifacts validates the produces and consumes declarations of a
+// pipeline's steps and returns, for each step, the indexes of the earlier
+// steps producing what it consumes. Files no step produces are inputs from
+// outside the pipeline.
+func planArtifacts(steps []*executorv1.BuildStep) ([][]int32, error) {
+	for i, step := range steps {
+		for _, path := range step.Produces {
+			if !filepath.IsLocal(path) {
+				return nil, invalidField(fmt.Sprintf("steps[%d].produces", i), "produces path %s must be relative and inside workspace_dir", path)
+			}
+		}
+	}
+
+	deps := make([][]int32, len(steps))
+	for i, step := range steps {
+		field := fmt.Sprintf("steps[%d].consumes", i)
+		for _, path := range step.Consumes {
+			if !filepath.IsLocal(path) {
+				return nil, invalidField(field, "consumes path %s must be relative and inside workspace_dir", path)
+			}
+			if producer := lastProducer(steps[:i], path); producer >= 0 {
+				deps[i] = append(deps[i], int32(producer))
+			} else if later := lastProducer(steps[i+1:], path); later >= 0 {
+				return nil, invalidField(field, "step %s consumes %s, which is only produced by the later step %s", step.Name, path, steps[i+1+later].Name)
+			}
+		}
+	}
+	return deps, nil
+}
+
+// lastProducer returns the index of the last of steps producing path, -1
+// if none does
+func lastProducer(steps []*executorv1.BuildStep, path string) int {
+	for i := len(steps) - 1; i >= 0; i-- {
+		for _, produced := range steps[i].Produces {
+			if filepath.Clean(produced) == filepath.Clean(path) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// missingArtifact returns the first of paths that does not exist under
+// dir, or "" if all do
+func missingArtifact(dir string, paths []string) string {
+	for _, path := range paths {
+		if _, err := os.Stat(filepath.Join(dir, path)); err != nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// checkProduced fails a successful response whose step did not create all
+// the files it produces
+func checkProduced(step *executorv1.BuildStep, dir string, response *executorv1.ExecuteResponse) {
+	if !response.Success {
+		return
+	}
+	if path := missingArtifact(dir, step.Produces); path != "" {
+		response.Success = false
+		response.ErrorCode = executorv1.ErrorCode_ERROR_CODE_ARTIFACT_MISSING
+		response.Error = fmt.Sprintf("step did not produce %s", path)
+	}
+}
@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+func TestPlanArtifacts(t *testing.T) {
+	tests := []struct {
+		name    string
+		steps   []*executorv1.BuildStep
+		want    [][]int32
+		wantErr string
+	}{
+		{
+			name: "consumes an earlier step's output",
+			steps: []*executorv1.BuildStep{
+				{Name: "build", Produces: []string{"bin/app"}},
+				{Name: "test", Consumes: []string{"bin/app"}},
+			},
+			want: [][]int32{nil, {0}},
+		},
+		{
+			name: "consumes and produces the same path",
+			steps: []*executorv1.BuildStep{
+				{Name: "cache", Consumes: []string{".cache"}, Produces: []string{".cache"}},
+			},
+			want: [][]int32{nil},
+		},
+		{
+			name: "updates an earlier step's output",
+			steps: []*executorv1.BuildStep{
+				{Name: "warm", Produces: []string{".cache"}},
+				{Name: "build", Consumes: []string{".cache"}, Produces: []string{".cache"}},
+			},
+			want: [][]int32{nil, {0}},
+		},
+		{
+			name: "consumes a later step's output",
+			steps: []*executorv1.BuildStep{
+				{Name: "test", Consumes: []string{"bin/app"}},
+				{Name: "lint"},
+				{Name: "build", Produces: []string{"bin/app"}},
+			},
+			wantErr: "only produced by the later step build",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := planArtifacts(tt.steps)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("planArtifacts error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("planArtifacts: %v", err)
+			}
+			if !slices.EqualFunc(got, tt.want, slices.Equal[[]int32]) {
+				t.Errorf("planArtifacts = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// skipReasonUnchanged is the skip reason of steps pruned by run_if_changed
+const skipReasonUnchanged = "no changed files match run_if_changed"
+
+// planChanged validates the run_if_changed globs of a pipeline's steps and
+// returns, for each step, whether it is skipped because none of the
+// pipeline's changed_files match them
+func planChanged(req *executorv1.PipelineRequest) ([]bool, error) {
+	skip := make([]bool, len(req.Steps))
+	for i, step := range req.Steps {
+		for _, glob := range step.RunIfChanged {
+			if _, err := path.Match(glob, ""); err != nil {
+				return nil, invalidField(fmt.Sprintf("steps[%d].run_if_changed", i), "invalid glob %s: %v", glob, err)
+			}
+		}
+		if len(req.ChangedFiles) > 0 && len(step.RunIfChanged) > 0 {
+			skip[i] = !anyChanged(step.RunIfChanged, req.ChangedFiles)
+		}
+	}
+	return skip, nil
+}
+
+// anyChanged reports whether any of files matches one of globs
+func anyChanged(globs, files []string) bool {
+	for _, file := range files {
+		file = strings.TrimPrefix(path.Clean(strings.ReplaceAll(file, "\\", "/")), "./")
+		for _, glob := range globs {
+			if matchGlob(strings.Split(glob, "/"), strings.Split(file, "/")) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchGlob matches the segments of a path against those of a glob, a "**"
+// segment matching any number of path segments
+func matchGlob(glob, name []string) bool {
+	for len(glob) > 0 {
+		if glob[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchGlob(glob[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(glob[0], name[0]); !ok {
+			return false
+		}
+		glob, name = glob[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+// unchangedStep returns the skipped result of a step pruned by
+// run_if_changed
+func unchangedStep(step *executorv1.BuildStep, index int) *executorv1.StepResult {
+	return &executorv1.StepResult{
+		Name:       step.Name,
+		StepIndex:  int32(index),
+		Skipped:    true,
+		SkipReason: skipReasonUnchanged,
+	}
+}
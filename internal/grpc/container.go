@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// containerize wraps a step request so it runs inside image using the
+// configured container runtime. The workspace is mounted at the same path
+// and the request env is passed through by name. The allowlist has already
+// been applied to the inner tool.
+func (s *ExecutorServer) containerize(req *executorv1.ExecuteRequest, workspace, image string) (*executorv1.ExecuteRequest, error) {
+	runtime := strings.Fields(s.config.ContainerRuntime)
+	if len(runtime) == 0 {
+		return nil, fieldError(codes.FailedPrecondition, reasonNotConfigured, "image", "no container runtime configured")
+	}
+
+	workDir, err := filepath.Abs(req.WorkDir)
+	if err != nil {
+		return nil, invalidField("work_dir", "invalid work_dir: %v", err)
+	}
+	if workspace == "" {
+		workspace = workDir
+	} else if workspace, err = filepath.Abs(workspace); err != nil {
+		return nil, invalidField("workspace_dir", "invalid workspace_dir: %v", err)
+	}
+
+	args := append([]string{}, runtime[1:]...)
+	args = append(args, "run", "--rm", "-v", workspace+":"+workspace, "-w", workDir)
+	for _, kv := range req.Env {
+		key, _, _ := strings.Cut(kv, "=")
+		args = append(args, "-e", key)
+	}
+	args = append(args, image, req.Tool)
+	args = append(args, req.Args...)
+
+	wrapped := proto.Clone(req).(*executorv1.ExecuteRequest)
+	wrapped.Tool = runtime[0]
+	wrapped.Args = args
+	return wrapped, nil
+}
+
+// prepullImages pulls the distinct images of steps concurrently when
+// executor.image_prepull is enabled, so the first step using an image does
+// not stall on the pull. Failures are only reported: the step's own run
+// pulls again and fails there if the image is unavailable. notify, which
+// may be nil, receives the progress of each pull one event at a time.
+func (s *ExecutorServer) prepullImages(ctx context.Context, steps []*executorv1.BuildStep, notify func(*executorv1.ImagePullEvent)) {
+	runtime := strings.Fields(s.config.ContainerRuntime)
+	if !s.config.ImagePrepull.Enabled || len(runtime) == 0 {
+		return
+	}
+
+	var images []string
+	seen := make(map[string]bool)
+	for _, step := range steps {
+		if step.Image != "" && !seen[step.Image] {
+			seen[step.Image] = true
+			images = append(images, step.Image)
+		}
+	}
+	if len(images) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(s.config.ImagePrepull.TimeoutSeconds)*time.Second)
+	defer cancel()
+
+	var mu sync.Mutex
+	report := func(event *executorv1.ImagePullEvent) {
+		if notify == nil {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		notify(event)
+	}
+
+	var wg sync.WaitGroup
+	for _, image := range images {
+		wg.Add(1)
+		go func(image string) {
+			defer wg.Done()
+
+			report(&executorv1.ImagePullEvent{Image: image, State: executorv1.ImagePullState_IMAGE_PULL_STATE_STARTED})
+			start := time.Now()
+
+			args := append(append([]string{}, runtime[1:]...), "pull", image)
+			cmd := exec.CommandContext(ctx, runtime[0], args...)
+			cmd.Env = s.environ(cmd)
+			output, err := cmd.CombinedOutput()
+
+			event := &executorv1.ImagePullEvent{
+				Image:      image,
+				State:      executorv1.ImagePullState_IMAGE_PULL_STATE_SUCCEEDED,
+				DurationMs: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				event.State = executorv1.ImagePullState_IMAGE_PULL_STATE_FAILED
+				event.Error = strings.TrimSpace(fmt.Sprintf("%v: %s", err, output))
+				s.logger.Warn("failed to pre-pull image", zap.String("image", image), zap.Error(err))
+			}
+			report(event)
+		}(image)
+	}
+	wg.Wait()
+}
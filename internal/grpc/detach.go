@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// detachedResult is the result of a detached execution, nil while it runs
+type detachedResult struct {
+	tenant      string // tenant that started the execution
+	response    *executorv1.ExecuteResponse
+	completedAt time.Time
+}
+
+// runDetachable runs req for an ExecuteStream call with detach_after_lines.
+// Once that many lines were streamed, or the client went away after the
+// process started, the stream is detached: the call may return while the
+// process keeps running, and finish is called when it completes. A client
+// going away before then cancels the execution. Otherwise the result is
+// returned as for an attached stream and the caller finishes the execution.
+func (s *ExecutorServer) runDetachable(
+	ctx context.Context,
+	req *executorv1.ExecuteRequest,
+	obs *observer,
+	sender *streamSender[*executorv1.ExecuteStreamResponse],
+	finish func(succeeded bool),
+) (response *executorv1.ExecuteResponse, detached bool, err error) {
+	detach := make(chan struct{})
+	started := make(chan struct{})
+	var processID atomic.Value
+	var lines atomic.Int32
+
+	wrapped := *obs
+	wrapped.started = func(id string) {
+		s.trackResult(id, callerTenant(ctx))
+		processID.Store(id)
+		close(started)
+		sender.Send(&executorv1.ExecuteStreamResponse{
+			Output: &executorv1.ExecuteStreamResponse_Started{
+				Started: &executorv1.StartedEvent{ProcessId: id, StartedAt: timestamppb.Now()},
+			},
+		})
+	}
+	wrapped.line = func(line string, isStdout bool) {
+		obs.line(line, isStdout)
+		if lines.Add(1) == req.DetachAfterLines {
+			close(detach)
+		}
+	}
+
+	type outcome struct {
+		response *executorv1.ExecuteResponse
+		err      error
+	}
+	done := make(chan outcome, 1)
+
+	// The process must outlive the call once detached
+	runCtx, cancelRun := context.WithCancel(context.WithoutCancel(ctx))
+	go func() {
+		defer cancelRun()
+		response, err := s.run(runCtx, req, nil, &wrapped)
+		if err == nil {
+			s.completeResult(response)
+		} else if id, _ := processID.Load().(string); id != "" {
+			// Failed after it started, so GetResult must not report it running
+			s.completeResult(&executorv1.ExecuteResponse{ProcessId: id, ExitCode: -1, Error: err.Error()})
+		}
+		done <- outcome{response, err}
+	}()
+
+	select {
+	case o := <-done:
+		return o.response, false, o.err
+	case <-detach:
+	case <-ctx.Done():
+		select {
+		case <-started:
+		default:
+			// Nothing ran yet that the client could later look up, so
+			// nothing may run at all
+			cancelRun()
+			<-done
+			return nil, false, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	id, _ := processID.Load().(string)
+	sender.SendAndStop(&executorv1.ExecuteStreamResponse{
+		Output: &executorv1.ExecuteStreamResponse_Detached{
+			Detached: &executorv1.DetachedEvent{ProcessId: id, LinesSent: lines.Load()},
+		},
+	})
+
+	go func() {
+		o := <-done
+		finish(o.err == nil && o.response.Success)
+	}()
+	return nil, true, nil
+}
+
+// trackResult records a detached execution of tenant as running
+func (s *ExecutorServer) trackResult(processID, tenant string) {
+	s.resultsMu.Lock()
+	defer s.resultsMu.Unlock()
+	s.results[processID] = &detachedResult{tenant: tenant}
+}
+
+// completeResult stores a detached execution's result and drops results
+// older than executor.result_retention
+func (s *ExecutorServer) completeResult(response *executorv1.ExecuteResponse) {
+	now := time.Now()
+
+	s.resultsMu.Lock()
+	defer s.resultsMu.Unlock()
+
+	s.pruneResultsLocked(now)
+	if result, ok := s.results[response.ProcessId]; ok {
+		s.results[response.ProcessId] = &detachedResult{tenant: result.tenant, response: response, completedAt: now}
+	}
+}
+
+// pruneResults drops results older than executor.result_retention, so they
+// expire even when no other detached execution completes
+func (s *ExecutorServer) pruneResults() {
+	s.resultsMu.Lock()
+	defer s.resultsMu.Unlock()
+	s.pruneResultsLocked(time.Now())
+}
+
+// pruneResultsLocked drops the results expired at now. s.resultsMu must be
+// held.
+func (s *ExecutorServer) pruneResultsLocked(now time.Time) {
+	retention := time.Duration(s.config.ResultRetention) * time.Second
+	for id, result := range s.results {
+		if result.response != nil && now.Sub(result.completedAt) > retention {
+			delete(s.results, id)
+		}
+	}
+}
+
+// GetResult returns the state and result of a detached execution
+func (s *ExecutorServer) GetResult(ctx context.Context, req *executorv1.GetResultRequest) (*executorv1.GetResultResponse, error) {
+	s.resultsMu.Lock()
+	result, ok := s.results[req.ProcessId]
+	s.resultsMu.Unlock()
+
+	if !ok || result.tenant != callerTenant(ctx) {
+		return nil, status.Errorf(codes.NotFound, "no detached execution %s", req.ProcessId)
+	}
+	if result.response == nil {
+		return &executorv1.GetResultResponse{State: executorv1.ResultState_RESULT_STATE_RUNNING}, nil
+	}
+	return &executorv1.GetResultResponse{
+		State:  executorv1.ResultState_RESULT_STATE_COMPLETED,
+		Result: result.response,
+	}, nil
+}
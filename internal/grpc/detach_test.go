@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"github.com/knullci/necrosword/internal/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDisconnectBeforeStartCancelsDetachable(t *testing.T) {
+	s := newTestServer(t, func(cfg *config.ExecutorConfig) { cfg.MaxConcurrent = 1 })
+
+	// Occupy the only slot, so the detachable execution waits for it
+	blockerCtx, stopBlocker := context.WithCancel(context.Background())
+	blockerDone := make(chan struct{})
+	go func() {
+		defer close(blockerDone)
+		s.Execute(blockerCtx, &executorv1.ExecuteRequest{Tool: "sleep", Args: []string{"30"}})
+	}()
+	defer func() {
+		stopBlocker()
+		<-blockerDone
+	}()
+	waitFor(t, func() bool { return runningCount(s) == 1 })
+
+	ctx, disconnect := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer disconnect()
+	stream := &testStream[*executorv1.ExecuteStreamResponse]{ctx: ctx}
+
+	err := s.ExecuteStream(&executorv1.ExecuteRequest{Tool: "echo", Args: []string{"hi"}, DetachAfterLines: 1}, stream)
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("ExecuteStream error = %v, want DeadlineExceeded", err)
+	}
+	for _, msg := range stream.messages() {
+		if msg.GetDetached() != nil || msg.GetStarted() != nil {
+			t.Errorf("sent %v for an execution that never started", msg)
+		}
+	}
+
+	// Freeing the slot must not start the abandoned execution
+	stopBlocker()
+	<-blockerDone
+	time.Sleep(100 * time.Millisecond)
+	if running := runningCount(s); running != 0 {
+		t.Errorf("%d processes running after the client went away", running)
+	}
+	s.resultsMu.Lock()
+	defer s.resultsMu.Unlock()
+	if len(s.results) != 0 {
+		t.Errorf("%d detached results tracked, want none", len(s.results))
+	}
+}
+
+// waitFor polls cond until it holds, failing the test after 5 seconds
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met within 5s")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
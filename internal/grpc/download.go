@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DownloadFile streams a file from under the workspace base in chunks
+func (s *ExecutorServer) DownloadFile(req *executorv1.DownloadFileRequest, stream executorv1.ExecutorService_DownloadFileServer) error {
+	cfg := s.config.Download
+	if !cfg.Enabled {
+		return status.Error(codes.FailedPrecondition, "file downloads are disabled")
+	}
+	ctx := stream.Context()
+	if err := authorizeBearer(ctx, cfg.Token, "download files"); err != nil {
+		return err
+	}
+
+	path, err := s.downloadPath(req.Path)
+	if err != nil {
+		return err
+	}
+	if err := s.checkTenantDir(ctx, "path", path); err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return status.Errorf(codes.NotFound, "file %s does not exist", req.Path)
+	} else if err != nil {
+		return fieldError(codes.FailedPrecondition, reasonInvalidField, "path", "failed to open %s: %v", req.Path, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fieldError(codes.FailedPrecondition, reasonInvalidField, "path", "failed to stat %s: %v", req.Path, err)
+	}
+	if !info.Mode().IsRegular() {
+		return invalidField("path", "%s is not a regular file", req.Path)
+	}
+	if info.Size() > cfg.MaxBytes {
+		return status.Errorf(codes.ResourceExhausted, "file %s is %d bytes, limit is %d", req.Path, info.Size(), cfg.MaxBytes)
+	}
+
+	s.logger.Info("downloading file", zap.String("path", path), zap.Int64("size", info.Size()))
+
+	// Serve at most the size seen, in case the file is still growing
+	r := io.LimitReader(f, info.Size())
+	digest := sha256.New()
+	buf := make([]byte, cfg.ChunkBytes)
+	var offset int64
+
+	for {
+		n, err := io.ReadFull(r, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return status.Errorf(codes.Internal, "failed to read %s: %v", req.Path, err)
+		}
+		last := err != nil || offset+int64(n) == info.Size()
+
+		digest.Write(buf[:n])
+		chunk := &executorv1.FileChunk{Data: buf[:n], Offset: offset}
+		if offset == 0 {
+			chunk.Size = info.Size()
+		}
+		if last {
+			chunk.Sha256 = hex.EncodeToString(digest.Sum(nil))
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+
+		offset += int64(n)
+		if last {
+			return nil
+		}
+	}
+}
+
+// downloadPath resolves a DownloadFile path, relative to the workspace base
+// unless absolute, and rejects paths outside the workspace base. Symlinks
+// are resolved so a link cannot lead outside it.
+func (s *ExecutorServer) downloadPath(path string) (string, error) {
+	if path == "" {
+		return "", invalidField("path", "path is required")
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(s.config.WorkspaceBase, path)
+	}
+
+	base, err := resolvePath(s.config.WorkspaceBase)
+	if err != nil {
+		return "", fieldError(codes.FailedPrecondition, reasonWorkDir, "path", "failed to resolve workspace base: %v", err)
+	}
+	resolved, err := resolvePath(path)
+	if err != nil {
+		return "", invalidField("path", "invalid path: %v", err)
+	}
+
+	if rel, err := filepath.Rel(base, resolved); err != nil || !filepath.IsLocal(rel) {
+		return "", fieldError(codes.PermissionDenied, reasonInvalidField, "path", "%s is outside the workspace base", path)
+	}
+	return resolved, nil
+}
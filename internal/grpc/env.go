@@ -0,0 +1,139 @@
+package grpc
+
+import (
+	"os/exec"
+	"path"
+	"runtime"
+	"strings"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// mergeEnv merges KEY=VALUE layers into one environment. Later layers take
+// precedence, so callers pass them as inherited, pipeline, step. Each key
+// appears once, at the position it first appeared. Keys are
+// case-insensitive on Windows. The result is never nil, so an empty
+// environment is not mistaken for "inherit everything" by exec.Cmd.
+func mergeEnv(layers ...[]string) []string {
+	merged := []string{}
+	index := make(map[string]int)
+
+	for _, layer := range layers {
+		for _, kv := range layer {
+			key, _, _ := strings.Cut(kv, "=")
+			if runtime.GOOS == "windows" {
+				key = strings.ToUpper(key)
+			}
+			if i, ok := index[key]; ok {
+				merged[i] = kv
+				continue
+			}
+			index[key] = len(merged)
+			merged = append(merged, kv)
+		}
+	}
+	return merged
+}
+
+// environ returns cmd's inherited environment without the variables
+// matching executor.strip_env_keys, with executor.global_env applied on top
+func (s *ExecutorServer) environ(cmd *exec.Cmd) []string {
+	env := cmd.Environ()
+	if len(s.config.StripEnvKeys) > 0 || s.secretEnvPrefix() != "" {
+		kept := env[:0]
+		for _, kv := range env {
+			key, _, _ := strings.Cut(kv, "=")
+			if !s.stripEnvKey(key) {
+				kept = append(kept, kv)
+			}
+		}
+		env = kept
+	}
+
+	if len(s.config.GlobalEnv) == 0 {
+		return env
+	}
+	return mergeEnv(env, s.config.GlobalEnv)
+}
+
+// stripEnvKey reports whether key matches one of executor.strip_env_keys or
+// holds a secret of the env secrets provider
+func (s *ExecutorServer) stripEnvKey(key string) bool {
+	if prefix := s.secretEnvPrefix(); prefix != "" && strings.HasPrefix(key, prefix) {
+		return true
+	}
+	for _, pattern := range s.config.StripEnvKeys {
+		if ok, _ := path.Match(pattern, key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// secretEnvPrefix returns the prefix of the variables read by the env
+// secrets provider, which commands must not inherit, or "" if it is not used
+func (s *ExecutorServer) secretEnvPrefix() string {
+	if s.config.Secrets.Provider != "env" {
+		return ""
+	}
+	return s.config.Secrets.EnvPrefix
+}
+
+// contextEnv returns the executor.inject_context_env variables identifying
+// an execution
+func (s *ExecutorServer) contextEnv(processID string, scope *pipelineScope) []string {
+	names := s.config.InjectContextEnv
+	if !names.Enabled {
+		return nil
+	}
+
+	var env []string
+	if names.ProcessID != "" {
+		env = append(env, names.ProcessID+"="+processID)
+	}
+	if scope != nil {
+		if names.PipelineID != "" {
+			env = append(env, names.PipelineID+"="+scope.pipelineID)
+		}
+		if names.StepName != "" {
+			env = append(env, names.StepName+"="+scope.stepName)
+		}
+	}
+	return env
+}
+
+// envSnapshot describes env for an EnvSnapshotEvent, redacting the values of
+// keys matching executor.env_snapshot.redact_keys. It returns nil when
+// snapshots are disabled.
+func (s *ExecutorServer) envSnapshot(processID string, env []string) *executorv1.EnvSnapshotEvent {
+	if !s.config.EnvSnapshot.Enabled {
+		return nil
+	}
+
+	event := &executorv1.EnvSnapshotEvent{
+		ProcessId: processID,
+		Vars:      make([]*executorv1.EnvVar, 0, len(env)),
+	}
+	for _, kv := range env {
+		key, value, _ := strings.Cut(kv, "=")
+		v := &executorv1.EnvVar{Key: key, Value: value}
+		if s.redactEnvKey(key) {
+			v.Value = ""
+			v.Redacted = true
+		}
+		event.Vars = append(event.Vars, v)
+	}
+	return event
+}
+
+// redactEnvKey reports whether key matches one of
+// executor.env_snapshot.redact_keys, ignoring case
+func (s *ExecutorServer) redactEnvKey(key string) bool {
+	key = strings.ToUpper(key)
+	for _, pattern := range s.config.EnvSnapshot.RedactKeys {
+		if ok, _ := path.Match(strings.ToUpper(pattern), key); ok {
+			return true
+		}
+	}
+	return false
+}
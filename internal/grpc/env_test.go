@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func TestMergeEnv(t *testing.T) {
+	tests := []struct {
+		name      string
+		inherited []string
+		pipeline  []string
+		step      []string
+		want      []string
+	}{
+		{
+			name: "empty",
+			want: []string{},
+		},
+		{
+			name:      "no collisions",
+			inherited: []string{"HOME=/root"},
+			pipeline:  []string{"CI=true"},
+			step:      []string{"GOFLAGS=-mod=mod"},
+			want:      []string{"HOME=/root", "CI=true", "GOFLAGS=-mod=mod"},
+		},
+		{
+			name:      "pipeline overrides inherited",
+			inherited: []string{"PATH=/usr/bin", "HOME=/root"},
+			pipeline:  []string{"PATH=/opt/bin"},
+			want:      []string{"PATH=/opt/bin", "HOME=/root"},
+		},
+		{
+			name:      "step overrides inherited",
+			inherited: []string{"PATH=/usr/bin"},
+			step:      []string{"PATH=/step/bin"},
+			want:      []string{"PATH=/step/bin"},
+		},
+		{
+			name:      "step overrides pipeline and inherited",
+			inherited: []string{"MODE=inherited", "HOME=/root"},
+			pipeline:  []string{"MODE=pipeline", "CI=true"},
+			step:      []string{"MODE=step"},
+			want:      []string{"MODE=step", "HOME=/root", "CI=true"},
+		},
+		{
+			name:     "step overrides pipeline",
+			pipeline: []string{"CI=true", "MODE=pipeline"},
+			step:     []string{"MODE=step"},
+			want:     []string{"CI=true", "MODE=step"},
+		},
+		{
+			name:     "last duplicate within a layer wins",
+			pipeline: []string{"MODE=first", "MODE=second"},
+			want:     []string{"MODE=second"},
+		},
+		{
+			name:      "empty value still overrides",
+			inherited: []string{"PROXY=http://proxy"},
+			step:      []string{"PROXY="},
+			want:      []string{"PROXY="},
+		},
+		{
+			name:      "value containing equals signs",
+			inherited: []string{"OPTS=a=1"},
+			step:      []string{"OPTS=a=2,b=3"},
+			want:      []string{"OPTS=a=2,b=3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeEnv(tt.inherited, tt.pipeline, tt.step)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("mergeEnv = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeEnvKeyCase(t *testing.T) {
+	got := mergeEnv([]string{"Path=/usr/bin"}, []string{"PATH=/opt/bin"})
+
+	want := []string{"Path=/usr/bin", "PATH=/opt/bin"}
+	if runtime.GOOS == "windows" {
+		want = []string{"PATH=/opt/bin"}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("mergeEnv = %q, want %q", got, want)
+	}
+}
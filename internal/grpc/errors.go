@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain identifies necrosword in ErrorInfo details
+const errorDomain = "necrosword.knullci.io"
+
+// Reasons reported in ErrorInfo details of rejected requests
+const (
+	reasonInvalidField   = "INVALID_FIELD"
+	reasonToolNotAllowed = "TOOL_NOT_ALLOWED"
+	reasonToolVersion    = "TOOL_VERSION_MISMATCH"
+	reasonWorkDir        = "WORK_DIR_UNAVAILABLE"
+	reasonNotConfigured  = "NOT_CONFIGURED"
+	reasonOtherTenant    = "OUTSIDE_TENANT_WORKSPACE"
+	reasonSecret         = "SECRET_UNAVAILABLE"
+	reasonStaleFence     = "STALE_FENCE_TOKEN"
+)
+
+// fieldError returns a status error for a rejected request field, with
+// ErrorInfo and BadRequest details naming the field and the reason so
+// clients need not parse the message
+func fieldError(code codes.Code, reason, field, format string, args ...any) error {
+	msg := fmt.Sprintf(format, args...)
+	st := status.New(code, msg)
+
+	withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason:   reason,
+			Domain:   errorDomain,
+			Metadata: map[string]string{"field": field},
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: msg},
+			},
+		},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// invalidField returns an InvalidArgument error for field
+func invalidField(field, format string, args ...any) error {
+	return fieldError(codes.InvalidArgument, reasonInvalidField, field, format, args...)
+}
@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// stepExpectation is what a step's stdout must contain to succeed
+type stepExpectation struct {
+	contains string
+	regex    *regexp.Regexp
+}
+
+// newStepExpectation compiles a step's expected output, returning nil when
+// the step sets none
+func newStepExpectation(step *executorv1.BuildStep) (*stepExpectation, error) {
+	if step.ExpectStdoutContains == "" && step.ExpectStdoutRegex == "" {
+		return nil, nil
+	}
+
+	expect := &stepExpectation{contains: step.ExpectStdoutContains}
+	if step.ExpectStdoutRegex != "" {
+		re, err := regexp.Compile(step.ExpectStdoutRegex)
+		if err != nil {
+			return nil, invalidField("expect_stdout_regex", "invalid expect_stdout_regex: %v", err)
+		}
+		expect.regex = re
+	}
+	return expect, nil
+}
+
+// check fails a successful response whose captured stdout does not meet the
+// expectation
+func (e *stepExpectation) check(response *executorv1.ExecuteResponse) {
+	if e == nil || !response.Success {
+		return
+	}
+
+	var unmet string
+	switch {
+	case e.contains != "" && !strings.Contains(response.Stdout, e.contains):
+		unmet = "stdout does not contain " + strconv.Quote(e.contains)
+	case e.regex != nil && !e.regex.MatchString(response.Stdout):
+		unmet = "stdout does not match " + strconv.Quote(e.regex.String())
+	default:
+		return
+	}
+
+	response.Success = false
+	response.ErrorCode = executorv1.ErrorCode_ERROR_CODE_EXPECTATION_FAILED
+	response.Error = unmet
+}
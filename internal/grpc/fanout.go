@@ -0,0 +1,203 @@
+package grpc
+
+import (
+	"sync"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// outputHub fans a process's output lines out to TailProcess subscribers.
+// It keeps a backlog of recent lines for late subscribers, and never blocks
+// the process on a slow subscriber: each has a bounded queue, and lines that
+// do not fit are counted and reported as dropped.
+type outputHub struct {
+	mu         sync.Mutex
+	backlog    []*executorv1.TailProcessResponse
+	maxBacklog int
+	queueLen   int
+	subs       map[*tailSubscriber]struct{}
+	finished   bool
+	result     *executorv1.ExecuteResponse
+}
+
+// tailSubscriber is one TailProcess call's view of a hub
+type tailSubscriber struct {
+	events  chan *executorv1.TailProcessResponse
+	dropped int64 // lines not queued since the last dropped marker, guarded by the hub
+}
+
+// newOutputHub returns a hub keeping maxBacklog lines and queueing up to
+// queueLen lines per subscriber
+func newOutputHub(maxBacklog, queueLen int) *outputHub {
+	return &outputHub{
+		maxBacklog: maxBacklog,
+		queueLen:   queueLen,
+		subs:       make(map[*tailSubscriber]struct{}),
+	}
+}
+
+// publish delivers an output line to every subscriber
+func (h *outputHub) publish(line string, isStdout bool) {
+	event := &executorv1.TailProcessResponse{}
+	if isStdout {
+		event.Event = &executorv1.TailProcessResponse_StdoutLine{StdoutLine: line}
+	} else {
+		event.Event = &executorv1.TailProcessResponse_StderrLine{StderrLine: line}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.maxBacklog > 0 {
+		if len(h.backlog) == h.maxBacklog {
+			h.backlog = h.backlog[1:]
+		}
+		h.backlog = append(h.backlog, event)
+	}
+
+	for sub := range h.subs {
+		if sub.dropped > 0 && !sub.offer(droppedEvent(sub.dropped)) {
+			sub.dropped++
+			continue
+		}
+		sub.dropped = 0
+		if !sub.offer(event) {
+			sub.dropped++
+		}
+	}
+}
+
+// finish ends every subscription with the process's result, nil if it did
+// not produce one. Only the first call has an effect.
+func (h *outputHub) finish(result *executorv1.ExecuteResponse) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.finished {
+		return
+	}
+	h.finished = true
+	h.result = result
+	for sub := range h.subs {
+		close(sub.events)
+	}
+}
+
+// subscribe starts a subscription, replaying the backlog first unless
+// skipBacklog is set
+func (h *outputHub) subscribe(skipBacklog bool) *tailSubscriber {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var backlog []*executorv1.TailProcessResponse
+	if !skipBacklog {
+		backlog = h.backlog
+	}
+
+	sub := &tailSubscriber{events: make(chan *executorv1.TailProcessResponse, len(backlog)+h.queueLen)}
+	for _, event := range backlog {
+		sub.events <- event
+	}
+
+	if h.finished {
+		close(sub.events)
+	} else {
+		h.subs[sub] = struct{}{}
+	}
+	return sub
+}
+
+// unsubscribe ends a subscription
+func (h *outputHub) unsubscribe(sub *tailSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subs, sub)
+}
+
+// outcome returns the lines a finished subscription still has to report as
+// dropped, and the process result
+func (h *outputHub) outcome(sub *tailSubscriber) (int64, *executorv1.ExecuteResponse) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return sub.dropped, h.result
+}
+
+// offer queues event without blocking, reporting whether it fit
+func (sub *tailSubscriber) offer(event *executorv1.TailProcessResponse) bool {
+	select {
+	case sub.events <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+// droppedEvent reports lines a subscriber missed
+func droppedEvent(lines int64) *executorv1.TailProcessResponse {
+	return &executorv1.TailProcessResponse{
+		Event: &executorv1.TailProcessResponse_Dropped{
+			Dropped: &executorv1.DroppedEvent{Lines: lines},
+		},
+	}
+}
+
+// withPublish wraps obs so every output line is also published to hub
+func withPublish(obs *observer, hub *outputHub) *observer {
+	wrapped := *obs
+	wrapped.line = func(line string, isStdout bool) {
+		hub.publish(line, isStdout)
+		if obs.line != nil {
+			obs.line(line, isStdout)
+		}
+	}
+	return &wrapped
+}
+
+// TailProcess streams a running process's output, starting with its recent
+// backlog, followed by its result once it exits. Any number of callers may
+// tail the same process.
+func (s *ExecutorServer) TailProcess(req *executorv1.TailProcessRequest, stream executorv1.ExecutorService_TailProcessServer) error {
+	s.mu.RLock()
+	proc, ok := s.running[req.ProcessId]
+	s.mu.RUnlock()
+
+	if !ok || proc.Tenant != callerTenant(stream.Context()) {
+		return status.Errorf(codes.NotFound, "process %s not found", req.ProcessId)
+	}
+	if proc.hub == nil {
+		return status.Errorf(codes.FailedPrecondition, "process %s runs with fast_path, its output cannot be tailed", req.ProcessId)
+	}
+
+	sub := proc.hub.subscribe(req.SkipBacklog)
+	defer proc.hub.unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case event, ok := <-sub.events:
+			if ok {
+				if err := stream.Send(event); err != nil {
+					return err
+				}
+				continue
+			}
+
+			dropped, result := proc.hub.outcome(sub)
+			if dropped > 0 {
+				if err := stream.Send(droppedEvent(dropped)); err != nil {
+					return err
+				}
+			}
+			if result == nil {
+				return nil
+			}
+			return stream.Send(&executorv1.TailProcessResponse{
+				Event: &executorv1.TailProcessResponse_Result{Result: result},
+			})
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// fence is the highest fence token seen for a job key
+type fence struct {
+	token  uint64
+	seenAt time.Time
+}
+
+// checkFence admits a request for jobKey holding token, rejecting it when a
+// higher token was seen for the job. Unfenced requests (token 0) are always
+// admitted. Fences not seen for executor.fence_retention are dropped.
+func (s *ExecutorServer) checkFence(ctx context.Context, jobKey string, token uint64) error {
+	if token == 0 {
+		return nil
+	}
+	if jobKey == "" {
+		return invalidField("job_key", "job_key is required with fence_token")
+	}
+	if tenant, ok := tenantFromContext(ctx); ok {
+		jobKey = tenant + "/" + jobKey
+	}
+
+	now := time.Now()
+	retention := time.Duration(s.config.FenceRetention) * time.Second
+
+	s.fencesMu.Lock()
+	defer s.fencesMu.Unlock()
+
+	for key, f := range s.fences {
+		if now.Sub(f.seenAt) > retention {
+			delete(s.fences, key)
+		}
+	}
+
+	f, ok := s.fences[jobKey]
+	if ok && token < f.token {
+		return fieldError(codes.FailedPrecondition, reasonStaleFence, "fence_token", "fence token %d for job %s is stale, %d was already seen", token, jobKey, f.token)
+	}
+	s.fences[jobKey] = &fence{token: token, seenAt: now}
+	return nil
+}
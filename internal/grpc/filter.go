@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// noOutputFilters is the request output_filters entry disabling filtering
+const noOutputFilters = "none"
+
+// ansiPattern matches ANSI escape sequences: CSI sequences such as colors
+// and cursor movement, OSC sequences such as titles and hyperlinks ended by
+// BEL or ST, character set selections and other two-byte escapes such as
+// keypad modes and cursor save/restore
+var ansiPattern = regexp.MustCompile(`\x1b(?:\[[0-?]*[ -/]*[@-~]|\][^\x07\x1b]*(?:\x07|\x1b\\)?|[()*+][0-9A-Za-z]|[0-~])`)
+
+// outputFilters maps the filter names of executor.output_filters to their
+// implementation
+var outputFilters = map[string]func(string) string{
+	"strip_ansi": stripANSI,
+}
+
+// stripANSI removes ANSI escape sequences from line
+func stripANSI(line string) string {
+	if strings.IndexByte(line, 0x1b) < 0 {
+		return line
+	}
+	return ansiPattern.ReplaceAllString(line, "")
+}
+
+// lineFilter transforms output lines. A nil lineFilter leaves them
+// unchanged.
+type lineFilter struct {
+	names []string
+	funcs []func(string) string
+}
+
+// outputFilter returns the filters applied to req's output, nil if none
+func (s *ExecutorServer) outputFilter(req *executorv1.ExecuteRequest) (*lineFilter, error) {
+	names := s.config.OutputFilters
+	if len(req.OutputFilters) > 0 {
+		names = req.OutputFilters
+		if slices.Contains(names, noOutputFilters) {
+			if len(names) > 1 {
+				return nil, invalidField("output_filters", "%s cannot be combined with other filters", noOutputFilters)
+			}
+			return nil, nil
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	f := &lineFilter{names: names}
+	for _, name := range names {
+		fn, ok := outputFilters[name]
+		if !ok {
+			return nil, invalidField("output_filters", "unknown output filter '%s'", name)
+		}
+		f.funcs = append(f.funcs, fn)
+	}
+	return f, nil
+}
+
+// apply returns line transformed by the filters
+func (f *lineFilter) apply(line string) string {
+	if f == nil {
+		return line
+	}
+	for _, fn := range f.funcs {
+		line = fn(line)
+	}
+	return line
+}
@@ -0,0 +1,37 @@
+package grpc
+
+import "testing"
+
+func TestStripANSI(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{name: "plain text", line: "build ok", want: "build ok"},
+		{name: "CSI color", line: "\x1b[31merror\x1b[0m: failed", want: "error: failed"},
+		{name: "CSI bold and color", line: "\x1b[1;32mPASS\x1b[m", want: "PASS"},
+		{name: "CSI 256 color", line: "\x1b[38;5;208mwarn\x1b[39m", want: "warn"},
+		{name: "CSI cursor movement", line: "\x1b[2K\x1b[1Gprogress 50%", want: "progress 50%"},
+		{name: "CSI private mode", line: "\x1b[?25lhidden cursor\x1b[?25h", want: "hidden cursor"},
+		{name: "OSC title ended by BEL", line: "\x1b]0;my title\x07prompt", want: "prompt"},
+		{name: "OSC title ended by ST", line: "\x1b]2;my title\x1b\\prompt", want: "prompt"},
+		{name: "OSC hyperlink ended by ST", line: "\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", want: "link"},
+		{name: "OSC hyperlink ended by BEL", line: "\x1b]8;;https://example.com\x07link\x1b]8;;\x07", want: "link"},
+		{name: "unterminated OSC", line: "text\x1b]0;title", want: "text"},
+		{name: "G0 charset select", line: "\x1b(Bascii", want: "ascii"},
+		{name: "G1 charset select", line: "\x1b)0line drawing", want: "line drawing"},
+		{name: "G2 and G3 charset select", line: "\x1b*A\x1b+Bdone", want: "done"},
+		{name: "keypad mode", line: "\x1b=app\x1b>", want: "app"},
+		{name: "cursor save and restore", line: "\x1b7saved\x1b8", want: "saved"},
+		{name: "mixed", line: "\x1b]0;job\x07\x1b(B\x1b[1mstep\x1b[0m done", want: "step done"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stripANSI(tt.line); got != tt.want {
+				t.Errorf("stripANSI(%q) = %q, want %q", tt.line, got, tt.want)
+			}
+		})
+	}
+}
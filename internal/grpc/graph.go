@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// addGraphNode records a step that ran in the pipeline's execution graph.
+// Steps run one after another, so each depends on the step run before it,
+// as well as on the producers of the files it consumes.
+func addGraphNode(response *executorv1.PipelineResponse, result *executorv1.StepResult, startedAt, endedAt time.Time, inputs []int32) {
+	if response.ExecutionGraph == nil {
+		response.ExecutionGraph = &executorv1.ExecutionGraph{}
+	}
+	graph := response.ExecutionGraph
+
+	node := &executorv1.ExecutionNode{
+		Name:       result.Name,
+		StepIndex:  result.StepIndex,
+		StartedAt:  timestamppb.New(startedAt),
+		EndedAt:    timestamppb.New(endedAt),
+		DurationMs: endedAt.Sub(startedAt).Milliseconds(),
+		Success:    result.ExecuteResult != nil && result.ExecuteResult.Success,
+	}
+	if n := len(graph.Nodes); n > 0 {
+		node.DependsOn = []int32{graph.Nodes[n-1].StepIndex}
+	}
+	for _, input := range inputs {
+		if !slices.Contains(node.DependsOn, input) {
+			node.DependsOn = append(node.DependsOn, input)
+		}
+	}
+	slices.Sort(node.DependsOn)
+	graph.Nodes = append(graph.Nodes, node)
+	graph.MaxParallelism = maxParallelism(graph.Nodes)
+}
+
+// maxParallelism returns the largest number of nodes running at once
+func maxParallelism(nodes []*executorv1.ExecutionNode) int32 {
+	type edge struct {
+		at    time.Time
+		delta int32
+	}
+	edges := make([]edge, 0, 2*len(nodes))
+	for _, node := range nodes {
+		edges = append(edges, edge{node.StartedAt.AsTime(), 1}, edge{node.EndedAt.AsTime(), -1})
+	}
+
+	// Ends sort before starts at the same instant, so back-to-back nodes do
+	// not count as overlapping
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].at.Equal(edges[j].at) {
+			return edges[i].delta < edges[j].delta
+		}
+		return edges[i].at.Before(edges[j].at)
+	})
+
+	var running, peak int32
+	for _, e := range edges {
+		running += e.delta
+		peak = max(peak, running)
+	}
+	return peak
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Tool health probe defaults
+const (
+	healthcheckTimeout     = 10 * time.Second
+	healthcheckOutputBytes = 4096
+)
+
+// CheckTool runs a tool's health probe and reports the result
+func (s *ExecutorServer) CheckTool(ctx context.Context, req *executorv1.CheckToolRequest) (*executorv1.CheckToolResponse, error) {
+	if req.Tool == "" {
+		return nil, invalidField("tool", "tool is required")
+	}
+	if _, ok := s.config.ToolHealthchecks[strings.ToLower(req.Tool)]; !ok {
+		return nil, fieldError(codes.FailedPrecondition, reasonNotConfigured, "tool", "no health check configured for tool '%s'", req.Tool)
+	}
+	return s.runToolCheck(ctx, req.Tool), nil
+}
+
+// checkAllTools runs every configured health probe, one after another
+func (s *ExecutorServer) checkAllTools() {
+	tools := make([]string, 0, len(s.config.ToolHealthchecks))
+	for tool := range s.config.ToolHealthchecks {
+		tools = append(tools, tool)
+	}
+	sort.Strings(tools)
+
+	for _, tool := range tools {
+		select {
+		case <-s.done:
+			return
+		default:
+		}
+		s.runToolCheck(context.Background(), tool)
+	}
+}
+
+// runToolCheck runs tool's probe and keeps the result for Health
+func (s *ExecutorServer) runToolCheck(ctx context.Context, tool string) *executorv1.CheckToolResponse {
+	key := strings.ToLower(tool)
+	check := s.config.ToolHealthchecks[key]
+
+	timeout := healthcheckTimeout
+	if check.TimeoutSeconds > 0 {
+		timeout = time.Duration(check.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	cmd := exec.CommandContext(ctx, tool, check.Args...)
+	cmd.Env = s.environ(cmd)
+	output, err := cmd.CombinedOutput()
+
+	result := &executorv1.CheckToolResponse{
+		Tool:       key,
+		Passed:     err == nil,
+		Output:     truncateUTF8(string(output), healthcheckOutputBytes),
+		DurationMs: time.Since(start).Milliseconds(),
+		CheckedAt:  timestamppb.Now(),
+	}
+	if err != nil {
+		result.Error = err.Error()
+		if ctx.Err() == context.DeadlineExceeded {
+			result.Error = "health check timed out after " + timeout.String()
+		}
+		s.logger.Warn("tool health check failed",
+			zap.String("tool", tool),
+			zap.String("error", result.Error))
+	} else {
+		s.logger.Debug("tool health check passed", zap.String("tool", tool))
+	}
+
+	s.toolChecks.Store(key, result)
+	return result
+}
+
+// toolCheckResults returns the latest probe results sorted by tool, and
+// whether any of them failed
+func (s *ExecutorServer) toolCheckResults() ([]*executorv1.CheckToolResponse, bool) {
+	var results []*executorv1.CheckToolResponse
+	failing := false
+	s.toolChecks.Range(func(_, value any) bool {
+		result := value.(*executorv1.CheckToolResponse)
+		results = append(results, result)
+		failing = failing || !result.Passed
+		return true
+	})
+	sort.Slice(results, func(i, j int) bool { return results[i].Tool < results[j].Tool })
+	return results, failing
+}
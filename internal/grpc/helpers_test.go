@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/knullci/necrosword/internal/config"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// newTestServer returns a server allowing a few shell tools, with configure
+// applied on top of the test defaults
+func newTestServer(t testing.TB, configure func(*config.ExecutorConfig)) *ExecutorServer {
+	t.Helper()
+
+	cfg := &config.ExecutorConfig{
+		AllowedTools:           []string{"sh", "echo", "sleep", "cat"},
+		DefaultTimeout:         30,
+		DefaultCancelSignal:    "SIGTERM",
+		CancelGracePeriod:      1,
+		MaxConcurrent:          4,
+		MaxPipelineOutputBytes: 1 << 20,
+		ScanBufferBytes:        64 * 1024,
+		MaxLineBytes:           1 << 20,
+		HeartbeatInterval:      1,
+		WorkspaceBase:          t.TempDir(),
+	}
+	cfg.AdmissionControl.Mode = "reject"
+	if configure != nil {
+		configure(cfg)
+	}
+	s := NewExecutorServer(cfg, zap.NewNop())
+	t.Cleanup(s.Close)
+	return s
+}
+
+// testStream is a server stream recording what the server sent
+type testStream[T any] struct {
+	grpc.ServerStream
+	ctx context.Context
+
+	mu   sync.Mutex
+	sent []T
+}
+
+func (s *testStream[T]) Context() context.Context { return s.ctx }
+
+func (s *testStream[T]) Send(msg T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+// messages returns the messages sent so far
+func (s *testStream[T]) messages() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.sent)
+}
+
+// runningCount returns the number of processes s tracks as running
+func runningCount(s *ExecutorServer) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.running)
+}
@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+)
+
+// isolatedHome creates a temporary HOME for one execution under
+// executor.isolated_home_dir. It returns the variables pointing HOME and the
+// XDG base directories at it and a function removing it.
+func (s *ExecutorServer) isolatedHome(processID string) ([]string, func(), error) {
+	base := s.config.IsolatedHomeDir
+	if base == "" {
+		base = os.TempDir()
+	}
+	if err := os.MkdirAll(base, 0o755); err != nil {
+		return nil, nil, fmt.Errorf("failed to create isolated home base: %w", err)
+	}
+
+	home, err := os.MkdirTemp(base, "home-"+processID+"-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create isolated home: %w", err)
+	}
+
+	env := []string{
+		"HOME=" + home,
+		"USERPROFILE=" + home,
+		"XDG_CONFIG_HOME=" + filepath.Join(home, ".config"),
+		"XDG_CACHE_HOME=" + filepath.Join(home, ".cache"),
+		"XDG_DATA_HOME=" + filepath.Join(home, ".local", "share"),
+		"XDG_STATE_HOME=" + filepath.Join(home, ".local", "state"),
+	}
+	remove := func() {
+		if err := os.RemoveAll(home); err != nil {
+			s.logger.Warn("failed to remove isolated home",
+				zap.String("process_id", processID),
+				zap.String("home", home),
+				zap.Error(err))
+		}
+	}
+	return env, remove, nil
+}
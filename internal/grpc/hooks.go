@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+)
+
+// runPostExecHook runs the configured post-execution hook for a finished
+// process. Hook failures are logged and only fail the execution when the
+// hook is configured with fail_on_error.
+func (s *ExecutorServer) runPostExecHook(ctx context.Context, req *executorv1.ExecuteRequest, response *executorv1.ExecuteResponse) {
+	hook := s.config.PostExecHook
+	if hook.Tool == "" {
+		return
+	}
+
+	// The hook runs even if the request was cancelled
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Duration(hook.Timeout)*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, hook.Tool, hook.Args...)
+	cmd.Dir = req.WorkDir
+	cmd.Env = append(s.environ(cmd),
+		"NECROSWORD_PROCESS_ID="+response.ProcessId,
+		"NECROSWORD_TOOL="+response.Tool,
+		"NECROSWORD_EXIT_CODE="+strconv.Itoa(int(response.ExitCode)),
+		"NECROSWORD_SUCCESS="+strconv.FormatBool(response.Success),
+		"NECROSWORD_WORKDIR="+req.WorkDir,
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		s.logger.Debug("post-exec hook completed",
+			zap.String("process_id", response.ProcessId),
+			zap.String("hook", hook.Tool))
+		return
+	}
+
+	s.logger.Warn("post-exec hook failed",
+		zap.String("process_id", response.ProcessId),
+		zap.String("hook", hook.Tool),
+		zap.String("output", truncateUTF8(string(output), 4096)),
+		zap.Error(err))
+
+	if hook.FailOnError {
+		response.Success = false
+		response.Error = fmt.Sprintf("post-exec hook failed: %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// activity records when a process last wrote output
+type activity struct {
+	last atomic.Int64 // unix nanoseconds
+}
+
+// touch records output now
+func (a *activity) touch() {
+	a.last.Store(time.Now().UnixNano())
+}
+
+// idleFor returns how long ago output was last recorded
+func (a *activity) idleFor() time.Duration {
+	return time.Since(time.Unix(0, a.last.Load()))
+}
+
+// withActivity wraps obs so every line or chunk read touches a
+func withActivity(obs *observer, a *activity) *observer {
+	wrapped := *obs
+	wrapped.line = func(line string, isStdout bool) {
+		a.touch()
+		if obs.line != nil {
+			obs.line(line, isStdout)
+		}
+	}
+	wrapped.chunk = func(data []byte, isStdout bool) {
+		a.touch()
+		if obs.chunk != nil {
+			obs.chunk(data, isStdout)
+		}
+	}
+	return &wrapped
+}
+
+// watchIdle calls onIdle once the process has written no output for
+// timeout, checking a few times per timeout. The returned function stops
+// watching.
+func watchIdle(a *activity, timeout time.Duration, onIdle func()) func() {
+	interval := min(max(timeout/4, 100*time.Millisecond), time.Second)
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if a.idleFor() >= timeout {
+					onIdle()
+					return
+				}
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ID schemes accepted by executor.id_scheme
+const (
+	idSchemeUUID = "uuid"
+	idSchemeULID = "ulid"
+	idSchemeSeq  = "seq"
+)
+
+// crockford is the ULID base32 alphabet
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// idGenerator mints process and pipeline IDs using the configured scheme
+type idGenerator struct {
+	scheme string
+	prefix string // distinguishes server instances for seq IDs
+	seq    atomic.Uint64
+}
+
+// newIDGenerator returns a generator for scheme
+func newIDGenerator(scheme string) *idGenerator {
+	g := &idGenerator{scheme: scheme}
+	if scheme == idSchemeSeq {
+		b := make([]byte, 3)
+		rand.Read(b)
+		g.prefix = hex.EncodeToString(b)
+	}
+	return g
+}
+
+// newID returns a new ID
+func (g *idGenerator) newID() string {
+	switch g.scheme {
+	case idSchemeULID:
+		return newULID(time.Now())
+	case idSchemeSeq:
+		return fmt.Sprintf("%s-%010d", g.prefix, g.seq.Add(1))
+	default:
+		return uuid.New().String()
+	}
+}
+
+// newULID returns a ULID for t: a 48-bit millisecond timestamp followed by
+// 80 random bits, base32 encoded so IDs sort by creation time
+func newULID(t time.Time) string {
+	var id [16]byte
+	binary.BigEndian.PutUint64(id[:8], uint64(t.UnixMilli())<<16)
+	rand.Read(id[6:])
+
+	// 128 bits as 26 characters of 5 bits, the first holding only 3
+	var out [26]byte
+	hi := binary.BigEndian.Uint64(id[:8])
+	lo := binary.BigEndian.Uint64(id[8:])
+	for i := 25; i >= 0; i-- {
+		out[i] = crockford[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(out[:])
+}
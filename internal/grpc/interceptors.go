@@ -0,0 +1,149 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// MethodLimits rejects requests larger than the configured size for their
+// method. Keys are method names without the service, matched case-insensitively.
+type MethodLimits map[string]int
+
+// NewMethodLimits normalizes the configured per-method size limits
+func NewMethodLimits(limits map[string]int) MethodLimits {
+	m := make(MethodLimits, len(limits))
+	for method, limit := range limits {
+		m[strings.ToLower(method)] = limit
+	}
+	return m
+}
+
+// check returns ResourceExhausted when req exceeds fullMethod's limit
+func (m MethodLimits) check(fullMethod string, req any) error {
+	limit, ok := m[strings.ToLower(path.Base(fullMethod))]
+	if !ok {
+		return nil
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	if size := proto.Size(msg); size > limit {
+		return status.Errorf(codes.ResourceExhausted, "request for %s is %d bytes, limit is %d", path.Base(fullMethod), size, limit)
+	}
+	return nil
+}
+
+// UnaryInterceptor enforces the limits on unary calls
+func (m MethodLimits) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := m.check(info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor enforces the limits on messages received by streaming calls
+func (m MethodLimits) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &limitedStream{ServerStream: ss, limits: m, method: info.FullMethod})
+	}
+}
+
+// limitedStream checks each received message against the method limit
+type limitedStream struct {
+	grpc.ServerStream
+	limits MethodLimits
+	method string
+}
+
+// RecvMsg implements grpc.ServerStream
+func (s *limitedStream) RecvMsg(msg any) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+	return s.limits.check(s.method, msg)
+}
+
+// MethodFilter rejects calls to methods outside the configured allowlist with
+// Unimplemented. Keys are method names without the service, matched
+// case-insensitively. An empty filter allows every method.
+type MethodFilter map[string]bool
+
+// filterExemptServices are served whatever the allowlist says, so grpcurl
+// and standard health probes keep working
+var filterExemptServices = map[string]bool{
+	"grpc.reflection.v1.ServerReflection":      true,
+	"grpc.reflection.v1alpha.ServerReflection": true,
+	"grpc.health.v1.Health":                    true,
+}
+
+// filterExemptMethods are served whatever the allowlist says, e.g. for load
+// balancer health checks
+var filterExemptMethods = map[string]bool{"Health": true}
+
+// NewMethodFilter normalizes the configured enabled methods
+func NewMethodFilter(methods []string) MethodFilter {
+	f := make(MethodFilter, len(methods))
+	for _, method := range methods {
+		f[strings.ToLower(method)] = true
+	}
+	return f
+}
+
+// Validate returns an error naming the first enabled method that none of
+// services has
+func (f MethodFilter) Validate(services map[string]grpc.ServiceInfo) error {
+	known := make(map[string]bool)
+	for _, info := range services {
+		for _, method := range info.Methods {
+			known[strings.ToLower(method.Name)] = true
+		}
+	}
+	for method := range f {
+		if !known[method] {
+			return fmt.Errorf("unknown method '%s'", method)
+		}
+	}
+	return nil
+}
+
+// check returns Unimplemented when fullMethod is not enabled
+func (f MethodFilter) check(fullMethod string) error {
+	service, method := path.Split(strings.TrimPrefix(fullMethod, "/"))
+	if len(f) == 0 || f[strings.ToLower(method)] ||
+		filterExemptServices[strings.TrimSuffix(service, "/")] || filterExemptMethods[method] {
+		return nil
+	}
+	return status.Errorf(codes.Unimplemented, "method %s is disabled", path.Base(fullMethod))
+}
+
+// UnaryInterceptor rejects unary calls to disabled methods
+func (f MethodFilter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := f.check(info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor rejects streaming calls to disabled methods
+func (f MethodFilter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := f.check(info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
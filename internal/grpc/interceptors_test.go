@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodFilter(t *testing.T) {
+	f := NewMethodFilter([]string{"Execute"})
+
+	tests := []struct {
+		method  string
+		allowed bool
+	}{
+		{method: "/executor.v1.ExecutorService/Execute", allowed: true},
+		{method: "/executor.v1.ExecutorService/Health", allowed: true},
+		{method: "/executor.v1.ExecutorService/ExecuteStream", allowed: false},
+		{method: "/executor.v1.ExecutorService/CancelProcess", allowed: false},
+		{method: "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo", allowed: true},
+		{method: "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo", allowed: true},
+		{method: "/grpc.health.v1.Health/Check", allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			err := f.check(tt.method)
+			if tt.allowed && err != nil {
+				t.Errorf("check = %v, want allowed", err)
+			}
+			if !tt.allowed && status.Code(err) != codes.Unimplemented {
+				t.Errorf("check = %v, want Unimplemented", err)
+			}
+		})
+	}
+}
+
+func TestEmptyMethodFilterAllowsAll(t *testing.T) {
+	if err := NewMethodFilter(nil).check("/executor.v1.ExecutorService/CancelProcess"); err != nil {
+		t.Errorf("check = %v, want allowed", err)
+	}
+}
@@ -0,0 +1,21 @@
+package grpc
+
+import "syscall"
+
+// ioprio_set arguments, see ioprio_set(2)
+const (
+	ioprioWhoPgrp    = 2
+	ioprioClassBE    = 2
+	ioprioClassShift = 13
+)
+
+// setIOPriority sets the best-effort I/O priority level of the process group
+// led by pid, like ionice -c 2 -n level
+func setIOPriority(pid, level int) error {
+	prio := ioprioClassBE<<ioprioClassShift | level
+	_, _, errno := syscall.Syscall(syscall.SYS_IOPRIO_SET, ioprioWhoPgrp, uintptr(pid), uintptr(prio))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
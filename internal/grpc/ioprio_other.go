@@ -0,0 +1,10 @@
+//go:build !linux
+
+package grpc
+
+import "errors"
+
+// setIOPriority is unsupported outside Linux
+func setIOPriority(pid, level int) error {
+	return errors.ErrUnsupported
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// IsolateCommand is the subcommand the namespace isolation mode re-runs the
+// server binary with to set up a command's mounts, see ExecIsolated
+const IsolateCommand = "isolate"
+
+// isolate confines cmd to executor.isolation.root. It must be called once
+// the command's Dir and Env are set.
+func (s *ExecutorServer) isolate(cmd *exec.Cmd) error {
+	cfg := s.config.Isolation
+	switch cfg.Mode {
+	case "chroot":
+		return chrootCommand(cmd, cfg.Root)
+	case "namespace":
+		return namespaceCommand(cmd, cfg.Root, s.isolationMounts())
+	}
+	return nil
+}
+
+// isolationMounts returns the bind mounts of the namespace mode, the
+// workspace base last
+func (s *ExecutorServer) isolationMounts() []string {
+	mounts := append([]string{}, s.config.Isolation.BindMounts...)
+	if s.config.WorkspaceBase == "" {
+		return mounts
+	}
+	if base, err := filepath.Abs(s.config.WorkspaceBase); err == nil {
+		mounts = append(mounts, base)
+	}
+	return mounts
+}
+
+// envPath returns the PATH of a command's environment
+func envPath(env []string) string {
+	path := ""
+	for _, kv := range env {
+		if value, ok := strings.CutPrefix(kv, "PATH="); ok {
+			path = value
+		}
+	}
+	return path
+}
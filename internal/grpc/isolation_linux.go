@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+
+	"github.com/knullci/necrosword/internal/config"
+)
+
+// chrootCommand runs cmd chrooted into root, looking its tool up inside
+// root. Dir is then a path inside root.
+func chrootCommand(cmd *exec.Cmd, root string) error {
+	path, err := lookPathIn(root, cmd.Args[0], envPath(cmd.Env))
+	if err != nil {
+		return err
+	}
+	cmd.Path, cmd.Err = path, nil
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Chroot = root
+	return nil
+}
+
+// lookPathIn finds the executable file inside root the way the shell would
+// after a chroot, returning its path inside root
+func lookPathIn(root, file, path string) (string, error) {
+	candidates := []string{file}
+	if !filepath.IsAbs(file) && filepath.Base(file) == file {
+		candidates = candidates[:0]
+		for _, dir := range filepath.SplitList(path) {
+			if filepath.IsAbs(dir) {
+				candidates = append(candidates, filepath.Join(dir, file))
+			}
+		}
+	}
+	for _, candidate := range candidates {
+		info, err := os.Stat(filepath.Join(root, candidate))
+		if err == nil && info.Mode().IsRegular() && info.Mode()&0o111 != 0 {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("%s not found in isolation root %s", file, root)
+}
+
+// namespaceCommand runs cmd through the IsolateCommand helper, which mounts
+// mounts into root in a private mount namespace and executes cmd chrooted
+// into it
+func namespaceCommand(cmd *exec.Cmd, root string, mounts []string) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to find the isolation helper: %w", err)
+	}
+	workDir := "/"
+	if cmd.Dir != "" {
+		if workDir, err = filepath.Abs(cmd.Dir); err != nil {
+			return err
+		}
+	}
+
+	args := []string{exe, IsolateCommand, "--root", root, "--workdir", workDir}
+	for _, mount := range mounts {
+		args = append(args, "--bind", mount)
+	}
+	args = append(args, "--")
+
+	cmd.Path, cmd.Err = exe, nil
+	cmd.Args = append(args, cmd.Args...)
+	cmd.Dir = ""
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Unshareflags |= syscall.CLONE_NEWNS
+	return nil
+}
+
+// ExecIsolated is the IsolateCommand helper. Started in a private mount
+// namespace, it bind-mounts mounts (src[:dst][:ro]) into root, chroots into
+// it and replaces itself with argv run in workDir. It only returns on
+// failure.
+func ExecIsolated(root, workDir string, mounts, argv []string) error {
+	if len(argv) == 0 {
+		return errors.New("no command given")
+	}
+	for _, spec := range mounts {
+		if err := bindMount(root, spec); err != nil {
+			return err
+		}
+	}
+
+	if err := syscall.Chroot(root); err != nil {
+		return fmt.Errorf("failed to chroot into %s: %w", root, err)
+	}
+	if err := os.Chdir(workDir); err != nil {
+		return fmt.Errorf("failed to enter working directory: %w", err)
+	}
+	path, err := exec.LookPath(argv[0])
+	if err != nil {
+		return err
+	}
+	return syscall.Exec(path, argv, os.Environ())
+}
+
+// bindMount mounts a src[:dst][:ro] bind mount below root, creating its
+// mount point
+func bindMount(root, spec string) error {
+	src, dst, readOnly, err := config.ParseBindMount(spec)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("bind mount source: %w", err)
+	}
+
+	target := filepath.Join(root, dst)
+	if info.IsDir() {
+		err = os.MkdirAll(target, 0o755)
+	} else if err = os.MkdirAll(filepath.Dir(target), 0o755); err == nil {
+		var f *os.File
+		if f, err = os.OpenFile(target, os.O_CREATE, 0o644); err == nil {
+			f.Close()
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create mount point %s: %w", target, err)
+	}
+
+	if err := syscall.Mount(src, target, "", syscall.MS_BIND|syscall.MS_REC, ""); err != nil {
+		return fmt.Errorf("failed to bind %s to %s: %w", src, target, err)
+	}
+	if readOnly {
+		if err := syscall.Mount("", target, "", syscall.MS_BIND|syscall.MS_REMOUNT|syscall.MS_RDONLY, ""); err != nil {
+			return fmt.Errorf("failed to make %s read-only: %w", target, err)
+		}
+	}
+	return nil
+}
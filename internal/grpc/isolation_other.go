@@ -0,0 +1,26 @@
+//go:build !linux
+
+package grpc
+
+import (
+	"errors"
+	"os/exec"
+)
+
+// errIsolationUnsupported is returned for executor.isolation outside Linux
+var errIsolationUnsupported = errors.New("executor.isolation is only supported on Linux")
+
+// chrootCommand is unsupported outside Linux
+func chrootCommand(cmd *exec.Cmd, root string) error {
+	return errIsolationUnsupported
+}
+
+// namespaceCommand is unsupported outside Linux
+func namespaceCommand(cmd *exec.Cmd, root string, mounts []string) error {
+	return errIsolationUnsupported
+}
+
+// ExecIsolated is unsupported outside Linux
+func ExecIsolated(root, workDir string, mounts, argv []string) error {
+	return errIsolationUnsupported
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"fmt"
+	"strings"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// resolveSteps replaces pipeline steps that use an executor.step_library
+// entry with the entry overridden by the step's own fields. req is returned
+// as is when no step uses the library.
+func (s *ExecutorServer) resolveSteps(req *executorv1.PipelineRequest) (*executorv1.PipelineRequest, error) {
+	var resolved *executorv1.PipelineRequest
+	for i, step := range req.Steps {
+		if step.Use == "" {
+			continue
+		}
+
+		entry, ok := s.config.StepLibrary[strings.ToLower(step.Use)]
+		if !ok {
+			return nil, invalidField(fmt.Sprintf("steps[%d].use", i), "unknown step library entry '%s'", step.Use)
+		}
+
+		base := &executorv1.BuildStep{
+			Name:            step.Use,
+			Tool:            entry.Tool,
+			Args:            entry.Args,
+			ShellCommand:    entry.ShellCommand,
+			WorkDir:         entry.WorkDir,
+			Env:             entry.Env,
+			TimeoutSeconds:  int32(entry.TimeoutSeconds),
+			Image:           entry.Image,
+			MaxRetries:      int32(entry.MaxRetries),
+			ContinueOnError: entry.ContinueOnError,
+		}
+		overrideStep(base, step)
+
+		if resolved == nil {
+			resolved = proto.Clone(req).(*executorv1.PipelineRequest)
+		}
+		resolved.Steps[i] = base
+	}
+
+	if resolved == nil {
+		return req, nil
+	}
+	return resolved, nil
+}
+
+// overrideStep applies the fields set on step to the library step base.
+// Lists replace the library's, except env which is layered on top of it.
+func overrideStep(base, step *executorv1.BuildStep) {
+	// The library's command is replaced by either kind of command
+	if step.Tool != "" || step.ShellCommand != "" {
+		base.Tool, base.Args, base.ShellCommand = "", nil, ""
+	}
+
+	baseMsg := base.ProtoReflect()
+	step.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsList() && fd.Name() != "env" {
+			baseMsg.Clear(fd)
+		}
+		return true
+	})
+
+	proto.Merge(base, step)
+	base.Use = ""
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"net"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// LimitListener wraps l so the server tracks open connections, closing new
+// ones straight away while max are open (0 = unlimited)
+func (s *ExecutorServer) LimitListener(l net.Listener, max int) net.Listener {
+	s.maxConnections = max
+	return &countingListener{Listener: l, server: s, max: int64(max)}
+}
+
+// countingListener counts accepted connections into server.connections
+type countingListener struct {
+	net.Listener
+	server *ExecutorServer
+	max    int64
+}
+
+// Accept returns the next connection within the limit
+func (l *countingListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		open := l.server.connections.Add(1)
+		if l.max > 0 && open > l.max {
+			l.server.connections.Add(-1)
+			l.server.logger.Warn("refusing connection, too many open connections",
+				zap.String("remote_addr", conn.RemoteAddr().String()),
+				zap.Int64("max_connections", l.max))
+			conn.Close()
+			continue
+		}
+
+		return &countedConn{Conn: conn, server: l.server}, nil
+	}
+}
+
+// countedConn releases its connection count once closed
+type countedConn struct {
+	net.Conn
+	server *ExecutorServer
+	once   sync.Once
+}
+
+// Close closes the connection
+func (c *countedConn) Close() error {
+	c.once.Do(func() {
+		c.server.connections.Add(-1)
+	})
+	return c.Conn.Close()
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// shouldLogArgs reports whether args of an execution may be logged. Tools in
+// executor.never_log_args_for are never logged regardless of the request.
+func (s *ExecutorServer) shouldLogArgs(tool string, logArgs *bool) bool {
+	for _, t := range s.config.NeverLogArgsFor {
+		if strings.EqualFold(t, tool) {
+			return false
+		}
+	}
+	return logArgs == nil || *logArgs
+}
+
+// argsFields logs args verbatim, or only their count and a hash when they
+// must not appear in logs
+func argsFields(args []string, logArgs bool) []zap.Field {
+	if logArgs {
+		return []zap.Field{zap.Strings("args", args)}
+	}
+
+	h := sha256.New()
+	for _, arg := range args {
+		h.Write([]byte(arg))
+		h.Write([]byte{0})
+	}
+	return []zap.Field{
+		zap.Int("args_count", len(args)),
+		zap.String("args_sha256", hex.EncodeToString(h.Sum(nil))),
+	}
+}
@@ -0,0 +1,215 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/knullci/necrosword/internal/config"
+	"go.uber.org/zap"
+)
+
+// forwardedLine is one output line queued for the log aggregator
+type forwardedLine struct {
+	time   time.Time
+	line   string
+	labels map[string]string
+}
+
+// logForwarder ships output lines to the executor.log_forward endpoint in
+// batches, in the background. Lines are dropped, not waited for, when the
+// queue is full so a slow aggregator never slows down a command.
+type logForwarder struct {
+	config  config.LogForwardConfig
+	client  *http.Client
+	logger  *zap.Logger
+	queue   chan forwardedLine
+	dropped atomic.Int64
+}
+
+// newLogForwarder creates a forwarder shipping lines until done is closed
+func newLogForwarder(cfg config.LogForwardConfig, logger *zap.Logger, done <-chan struct{}) *logForwarder {
+	f := &logForwarder{
+		config: cfg,
+		client: &http.Client{Timeout: time.Duration(cfg.TimeoutSeconds) * time.Second},
+		logger: logger,
+		queue:  make(chan forwardedLine, cfg.QueueLines),
+	}
+	go f.run(done)
+	return f
+}
+
+// observe wraps obs so every line read is also forwarded with labels
+func (f *logForwarder) observe(obs *observer, labels map[string]string) *observer {
+	wrapped := *obs
+	wrapped.line = func(line string, isStdout bool) {
+		f.forward(line, isStdout, labels)
+		if obs.line != nil {
+			obs.line(line, isStdout)
+		}
+	}
+	return &wrapped
+}
+
+// forward queues a line, dropping it if the queue is full
+func (f *logForwarder) forward(line string, isStdout bool, labels map[string]string) {
+	stream := "stderr"
+	if isStdout {
+		stream = "stdout"
+	}
+	lineLabels := make(map[string]string, len(f.config.Labels)+len(labels)+1)
+	maps.Copy(lineLabels, f.config.Labels)
+	maps.Copy(lineLabels, labels)
+	lineLabels["stream"] = stream
+
+	select {
+	case f.queue <- forwardedLine{time: time.Now(), line: line, labels: lineLabels}:
+	default:
+		f.dropped.Add(1)
+	}
+}
+
+// run batches queued lines, sending a batch when it is full or the flush
+// interval passes, and sends what is left once done is closed
+func (f *logForwarder) run(done <-chan struct{}) {
+	ticker := time.NewTicker(time.Duration(f.config.FlushIntervalMs) * time.Millisecond)
+	defer ticker.Stop()
+
+	batch := make([]forwardedLine, 0, f.config.BatchLines)
+	flush := func() {
+		if len(batch) > 0 {
+			f.send(batch)
+			batch = batch[:0]
+		}
+		if n := f.dropped.Swap(0); n > 0 {
+			f.logger.Warn("dropped output lines, log forward queue is full", zap.Int64("lines", n))
+		}
+	}
+
+	for {
+		select {
+		case <-done:
+			for {
+				select {
+				case line := <-f.queue:
+					batch = append(batch, line)
+					if len(batch) >= f.config.BatchLines {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		case line := <-f.queue:
+			batch = append(batch, line)
+			if len(batch) >= f.config.BatchLines {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// send delivers a batch, retrying with a doubling backoff. The batch is
+// dropped once the retries are used up.
+func (f *logForwarder) send(batch []forwardedLine) {
+	body, err := f.encode(batch)
+	if err != nil {
+		f.logger.Warn("failed to encode forwarded output", zap.Error(err))
+		return
+	}
+
+	backoff := time.Second
+	for attempt := 0; ; attempt++ {
+		err = f.post(body)
+		if err == nil {
+			return
+		}
+		if attempt >= f.config.MaxRetries {
+			break
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+
+	f.logger.Warn("failed to forward output, dropping batch",
+		zap.String("endpoint", f.config.Endpoint),
+		zap.Int("lines", len(batch)),
+		zap.Error(err))
+}
+
+// post sends one encoded batch
+func (f *logForwarder) post(body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), f.client.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// encode builds the request body for the configured aggregator type
+func (f *logForwarder) encode(batch []forwardedLine) ([]byte, error) {
+	if f.config.Type == "loki" {
+		return encodeLoki(batch)
+	}
+
+	// fluentd's in_http and plain http endpoints take a JSON array of records
+	records := make([]map[string]any, 0, len(batch))
+	for _, l := range batch {
+		record := make(map[string]any, len(l.labels)+2)
+		for k, v := range l.labels {
+			record[k] = v
+		}
+		record["time"] = l.time.UTC().Format(time.RFC3339Nano)
+		record["line"] = l.line
+		records = append(records, record)
+	}
+	return json.Marshal(records)
+}
+
+// encodeLoki builds a Loki push request, grouping lines by their labels
+func encodeLoki(batch []forwardedLine) ([]byte, error) {
+	type lokiStream struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	}
+
+	var streams []*lokiStream
+	byLabels := make(map[string]*lokiStream)
+	for _, l := range batch {
+		key, err := json.Marshal(l.labels)
+		if err != nil {
+			return nil, err
+		}
+		stream, ok := byLabels[string(key)]
+		if !ok {
+			stream = &lokiStream{Stream: l.labels}
+			byLabels[string(key)] = stream
+			streams = append(streams, stream)
+		}
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(l.time.UnixNano(), 10), l.line})
+	}
+	return json.Marshal(map[string]any{"streams": streams})
+}
@@ -0,0 +1,196 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LogBroadcaster is a zapcore.Core forwarding log entries to StreamLogs
+// subscribers. It only does work while someone is subscribed, and drops
+// entries for subscribers that fall behind rather than slowing logging.
+type LogBroadcaster struct {
+	mu       sync.Mutex
+	subs     map[*logSubscriber]struct{}
+	active   atomic.Int32
+	queueLen int
+	encoder  zapcore.Encoder
+}
+
+// logSubscriber is one StreamLogs call
+type logSubscriber struct {
+	level   zapcore.Level
+	entries chan *executorv1.LogEntry
+	dropped atomic.Int64
+}
+
+// NewLogBroadcaster returns a broadcaster queueing up to queueLen entries
+// per subscriber
+func NewLogBroadcaster(queueLen int) *LogBroadcaster {
+	return &LogBroadcaster{
+		subs:     make(map[*logSubscriber]struct{}),
+		queueLen: queueLen,
+		encoder:  zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()),
+	}
+}
+
+// Core returns the broadcaster as a zapcore.Core, to be teed with the
+// logger's own core
+func (b *LogBroadcaster) Core() zapcore.Core {
+	return &broadcastCore{b: b, enc: b.encoder}
+}
+
+// subscribe registers a subscriber for entries at level or above
+func (b *LogBroadcaster) subscribe(level zapcore.Level) *logSubscriber {
+	sub := &logSubscriber{level: level, entries: make(chan *executorv1.LogEntry, b.queueLen)}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs[sub] = struct{}{}
+	b.active.Add(1)
+	return sub
+}
+
+// unsubscribe removes a subscriber
+func (b *LogBroadcaster) unsubscribe(sub *logSubscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subs, sub)
+	b.active.Add(-1)
+}
+
+// publish queues entry for every subscriber interested in level
+func (b *LogBroadcaster) publish(level zapcore.Level, entry *executorv1.LogEntry) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subs {
+		if level < sub.level {
+			continue
+		}
+		select {
+		case sub.entries <- entry:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+}
+
+// broadcastCore is the zapcore.Core view of a LogBroadcaster, carrying the
+// fields added with With
+type broadcastCore struct {
+	b   *LogBroadcaster
+	enc zapcore.Encoder
+}
+
+// Enabled is true for every level while anyone is subscribed
+func (c *broadcastCore) Enabled(zapcore.Level) bool {
+	return c.b.active.Load() > 0
+}
+
+// With returns a core adding fields to every entry
+func (c *broadcastCore) With(fields []zapcore.Field) zapcore.Core {
+	enc := c.enc.Clone()
+	for _, field := range fields {
+		field.AddTo(enc)
+	}
+	return &broadcastCore{b: c.b, enc: enc}
+}
+
+// Check adds the core to entries that should be broadcast
+func (c *broadcastCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Write encodes the entry and publishes it
+func (c *broadcastCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := c.enc.EncodeEntry(ent, fields)
+	if err != nil {
+		return err
+	}
+	line := strings.TrimSuffix(buf.String(), "\n")
+	buf.Free()
+
+	c.b.publish(ent.Level, &executorv1.LogEntry{
+		Time:    timestamppb.New(ent.Time),
+		Level:   ent.Level.String(),
+		Logger:  ent.LoggerName,
+		Message: ent.Message,
+		Json:    line,
+	})
+	return nil
+}
+
+// Sync is a no-op, entries are delivered as they are written
+func (c *broadcastCore) Sync() error {
+	return nil
+}
+
+// SetLogBroadcaster makes the logs teed into b available through StreamLogs
+func (s *ExecutorServer) SetLogBroadcaster(b *LogBroadcaster) {
+	s.logs = b
+}
+
+// StreamLogs streams the server's log entries at or above the requested
+// level until the client goes away
+func (s *ExecutorServer) StreamLogs(req *executorv1.StreamLogsRequest, stream executorv1.ExecutorService_StreamLogsServer) error {
+	if !s.config.LogStream.Enabled || s.logs == nil {
+		return status.Error(codes.FailedPrecondition, "log streaming is disabled")
+	}
+	if err := authorizeBearer(stream.Context(), s.config.LogStream.Token, "stream logs"); err != nil {
+		return err
+	}
+
+	level := zapcore.DebugLevel
+	if req.MinLevel != "" {
+		if err := level.UnmarshalText([]byte(req.MinLevel)); err != nil {
+			return invalidField("min_level", "invalid min_level '%s'", req.MinLevel)
+		}
+	}
+
+	sub := s.logs.subscribe(level)
+	defer s.logs.unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case entry := <-sub.entries:
+			entry = proto.Clone(entry).(*executorv1.LogEntry)
+			entry.Dropped = sub.dropped.Swap(0)
+			if err := stream.Send(entry); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// authorizeBearer checks the bearer token required for an action, if one
+// is configured
+func authorizeBearer(ctx context.Context, token, action string) error {
+	if token == "" {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		if subtle.ConstantTimeCompare([]byte(value), []byte("Bearer "+token)) == 1 {
+			return nil
+		}
+	}
+	return status.Errorf(codes.Unauthenticated, "a valid bearer token is required to %s", action)
+}
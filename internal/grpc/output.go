@@ -0,0 +1,388 @@
+package grpc
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// observer receives live events from a running process. Nil callbacks are skipped.
+type observer struct {
+	started  func(processID string)
+	line     func(line string, isStdout bool)
+	chunk    func(data []byte, isStdout bool)
+	limit    func(event *executorv1.LimitEvent)
+	deadline func(event *executorv1.DeadlineEvent)
+	progress func(event *executorv1.ProgressEvent)
+	env      func(event *executorv1.EnvSnapshotEvent)
+
+	// rateLimited reports lines held back by max_output_lines_per_sec
+	rateLimited func(event *executorv1.RateLimitedEvent)
+}
+
+// errStreamStopped is returned by sends after a stream was stopped
+var errStreamStopped = errors.New("stream stopped")
+
+// streamSender serializes sends on a server stream, which must not be used
+// from several goroutines at once, and stops sending after the first failure
+type streamSender[T any] struct {
+	mu     sync.Mutex
+	send   func(T) error
+	logger *zap.Logger
+	err    error
+}
+
+// Send delivers msg unless an earlier send already failed
+func (ss *streamSender[T]) Send(msg T) error {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	if ss.err != nil {
+		return ss.err
+	}
+	if err := ss.send(msg); err != nil {
+		ss.logger.Warn("failed to stream output", zap.Error(err))
+		ss.err = err
+	}
+	return ss.err
+}
+
+// SendAndStop delivers a final msg, after which nothing more is sent
+func (ss *streamSender[T]) SendAndStop(msg T) error {
+	err := ss.Send(msg)
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if ss.err == nil {
+		ss.err = errStreamStopped
+	}
+	return err
+}
+
+// countingReader counts the bytes read through it into n
+type countingReader struct {
+	r io.Reader
+	n *atomic.Int64
+}
+
+// Read implements io.Reader
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n.Add(int64(n))
+	return n, err
+}
+
+// capture accumulates one output stream up to a byte limit (0 = unlimited),
+// calling onLimit the first time output is cut
+type capture struct {
+	buf       strings.Builder
+	kept      int64 // bytes kept in buf and the spill file
+	limit     int64
+	truncated bool
+	onLimit   func()
+
+	// spill receives output beyond its threshold, nil if spilling is disabled.
+	// With tail set it receives all output instead.
+	spill *spillFile
+
+	// tail keeps only the most recent lines in memory, nil to keep everything
+	tail *lineRing
+
+	// discard drops all output, which is then only counted
+	discard bool
+
+	// hash digests the kept output when requested, nil otherwise
+	hash hash.Hash
+
+	// mask hides secrets in the output, nil if the command has none
+	mask *masker
+
+	// filter transforms lines read from the stream, nil if none is applied
+	filter *lineFilter
+
+	// written counts every byte the command wrote to the stream
+	written atomic.Int64
+}
+
+// writeLine appends a line read from the stream
+func (c *capture) writeLine(line string) {
+	c.write(line, true)
+}
+
+// Write captures raw output, so a capture can be used directly as a
+// command's stdout or stderr
+func (c *capture) Write(p []byte) (int, error) {
+	c.written.Add(int64(len(p)))
+	c.write(string(p), false)
+	return len(p), nil
+}
+
+// write keeps data, followed by a newline if requested, within the limit
+func (c *capture) write(data string, newline bool) {
+	if c.discard {
+		return
+	}
+	data = c.mask.mask(data)
+	if c.tail != nil {
+		c.tail.write(data, newline)
+	}
+	if c.truncated {
+		return
+	}
+
+	size := int64(len(data))
+	if newline {
+		size++
+	}
+
+	if c.limit > 0 && c.kept+size > c.limit {
+		c.store(truncateUTF8(data, c.limit-c.kept))
+		c.cut()
+		return
+	}
+
+	c.store(data)
+	if newline {
+		c.store("\n")
+	}
+}
+
+// store keeps data in memory, or in the spill file once the in-memory
+// threshold is reached. Output stays in memory if the spill file fails.
+func (c *capture) store(data string) {
+	c.kept += int64(len(data))
+	if c.hash != nil {
+		io.WriteString(c.hash, data)
+	}
+
+	// The tail holds the in-memory copy, the spill file the full output
+	if c.tail != nil {
+		if c.spill != nil {
+			c.spill.write(data)
+		}
+		return
+	}
+
+	if c.spill != nil && (c.spill.started() || int64(c.buf.Len()+len(data)) > c.spill.threshold) {
+		head := ""
+		if !c.spill.started() {
+			head = truncateUTF8(data, c.spill.threshold-int64(c.buf.Len()))
+		}
+		if c.spill.write(data[len(head):]) {
+			c.buf.WriteString(head)
+			return
+		}
+	}
+
+	c.buf.WriteString(data)
+}
+
+// sum returns the hex SHA-256 of the kept output, in memory and spilled, or
+// of the returned tail, and whether that is only part of the output. It
+// returns "" unless hashing was requested.
+func (c *capture) sum() (string, bool) {
+	if c.hash == nil {
+		return "", false
+	}
+	if c.tail != nil {
+		digest := sha256.Sum256([]byte(c.tail.String()))
+		return hex.EncodeToString(digest[:]), c.tail.dropped()
+	}
+	return hex.EncodeToString(c.hash.Sum(nil)), c.truncated
+}
+
+// cut marks the capture truncated
+func (c *capture) cut() {
+	c.truncated = true
+	if c.onLimit != nil {
+		c.onLimit()
+	}
+}
+
+// String returns the captured output. Raw writes may have been cut inside a
+// rune, which is dropped so the result stays valid UTF-8.
+func (c *capture) String() string {
+	if c.tail != nil {
+		return c.tail.String()
+	}
+
+	out := c.buf.String()
+	if !c.truncated {
+		return out
+	}
+	for i := 0; i < utf8.UTFMax-1 && len(out) > 0; i++ {
+		if r, size := utf8.DecodeLastRuneInString(out); r != utf8.RuneError || size != 1 {
+			break
+		}
+		out = out[:len(out)-1]
+	}
+	return out
+}
+
+// lineRing keeps the last lines written to it
+type lineRing struct {
+	lines []string
+	next  int
+	full  bool
+
+	// partial is an unterminated line from raw writes
+	partial strings.Builder
+
+	// pushed counts every complete line written
+	pushed int
+}
+
+// newLineRing returns a ring holding up to n lines
+func newLineRing(n int) *lineRing {
+	return &lineRing{lines: make([]string, n)}
+}
+
+// write adds a complete line, or raw output split at newlines
+func (r *lineRing) write(data string, newline bool) {
+	if newline {
+		r.push(data)
+		return
+	}
+
+	for {
+		i := strings.IndexByte(data, '\n')
+		if i < 0 {
+			r.partial.WriteString(data)
+			return
+		}
+		r.partial.WriteString(data[:i])
+		r.push(r.partial.String())
+		r.partial.Reset()
+		data = data[i+1:]
+	}
+}
+
+// push adds a line, evicting the oldest once the ring is full
+func (r *lineRing) push(line string) {
+	r.pushed++
+	r.lines[r.next] = line
+	r.next = (r.next + 1) % len(r.lines)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// String returns the retained lines, oldest first. An unterminated last
+// line counts as one of them.
+func (r *lineRing) String() string {
+	lines := r.lines[:r.next]
+	if r.full {
+		lines = append(append([]string{}, r.lines[r.next:]...), lines...)
+	}
+	if r.partial.Len() > 0 && len(lines) == len(r.lines) {
+		lines = lines[1:]
+	}
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	b.WriteString(r.partial.String())
+	return b.String()
+}
+
+// dropped reports whether String leaves out lines that were written
+func (r *lineRing) dropped() bool {
+	if r.partial.Len() > 0 {
+		return r.pushed >= len(r.lines)
+	}
+	return r.pushed > len(r.lines)
+}
+
+// limitRecorder collects the limits enforced during an execution and
+// forwards each one to the observer the moment it fires
+type limitRecorder struct {
+	mu     sync.Mutex
+	events []*executorv1.LimitEvent
+	notify func(event *executorv1.LimitEvent)
+}
+
+// record notes that limit was enforced at the configured value
+func (l *limitRecorder) record(limit executorv1.LimitKind, value int64, detail string) {
+	event := &executorv1.LimitEvent{
+		Limit:      limit,
+		Value:      value,
+		Detail:     detail,
+		OccurredAt: timestamppb.Now(),
+	}
+
+	l.mu.Lock()
+	l.events = append(l.events, event)
+	l.mu.Unlock()
+
+	if l.notify != nil {
+		l.notify(event)
+	}
+}
+
+// list returns the recorded limit events
+func (l *limitRecorder) list() []*executorv1.LimitEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.events
+}
+
+// truncatingLines is a bufio.SplitFunc like bufio.ScanLines that cuts lines
+// longer than maxLine, at a rune boundary, instead of failing, discarding the
+// rest of the line. onTruncate is called once for every line that was cut.
+func truncatingLines(maxLine int, onTruncate func()) bufio.SplitFunc {
+	discarding := false
+
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if discarding {
+			i := bytes.IndexByte(data, '\n')
+			if i < 0 {
+				if atEOF {
+					discarding = false
+				}
+				return len(data), nil, nil
+			}
+			discarding = false
+			return i + 1, nil, nil
+		}
+
+		advance, token, err := bufio.ScanLines(data, atEOF)
+		if advance > 0 || token != nil || err != nil {
+			return advance, token, err
+		}
+
+		// No complete line yet and the buffer is full
+		if len(data) >= maxLine {
+			discarding = true
+			onTruncate()
+			return maxLine, data[:runeBoundary(data, maxLine)], nil
+		}
+		return 0, nil, nil
+	}
+}
+
+// runeBoundary backs n off to the start of a rune that data[:n] would cut
+func runeBoundary(data []byte, n int) int {
+	for i := n - 1; i >= 0 && i >= n-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:n]) {
+				return i
+			}
+			break
+		}
+	}
+	return n
+}
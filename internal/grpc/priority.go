@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"errors"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+)
+
+// Accepted ranges for nice values and best-effort I/O priority levels
+const (
+	minNice       = -20
+	maxNice       = 19
+	minIOPriority = 0
+	maxIOPriority = 7
+)
+
+// validatePriority checks the requested CPU and I/O priorities
+func validatePriority(req *executorv1.ExecuteRequest) error {
+	if req.Priority != nil && (*req.Priority < minNice || *req.Priority > maxNice) {
+		return invalidField("priority", "priority must be between %d and %d", minNice, maxNice)
+	}
+	if req.IoPriority != nil && (*req.IoPriority < minIOPriority || *req.IoPriority > maxIOPriority) {
+		return invalidField("io_priority", "io_priority must be between %d and %d", minIOPriority, maxIOPriority)
+	}
+	return nil
+}
+
+// applyPriority sets the requested priorities on a started process group.
+// Failures are logged; the process keeps running at its inherited priority.
+func (s *ExecutorServer) applyPriority(proc *RunningProcess, req *executorv1.ExecuteRequest) {
+	pid := proc.Command.Process.Pid
+
+	if req.Priority != nil {
+		s.logPriorityError(proc, "priority", setNice(pid, int(*req.Priority)))
+	}
+	if req.IoPriority != nil {
+		s.logPriorityError(proc, "io_priority", setIOPriority(pid, int(*req.IoPriority)))
+	}
+}
+
+// logPriorityError logs why a priority could not be applied, if it failed
+func (s *ExecutorServer) logPriorityError(proc *RunningProcess, field string, err error) {
+	switch {
+	case err == nil:
+	case errors.Is(err, errors.ErrUnsupported):
+		s.logger.Info("ignoring "+field+", not supported on this platform",
+			zap.String("process_id", proc.ID))
+	default:
+		s.logger.Warn("failed to set "+field,
+			zap.String("process_id", proc.ID),
+			zap.Error(err))
+	}
+}
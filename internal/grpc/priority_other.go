@@ -0,0 +1,10 @@
+//go:build !unix
+
+package grpc
+
+import "errors"
+
+// setNice is unsupported where process priorities are not available
+func setNice(pid, nice int) error {
+	return errors.ErrUnsupported
+}
@@ -0,0 +1,10 @@
+//go:build unix
+
+package grpc
+
+import "syscall"
+
+// setNice sets the nice value of the process group led by pid
+func setNice(pid, nice int) error {
+	return syscall.Setpriority(syscall.PRIO_PGRP, pid, nice)
+}
@@ -0,0 +1,25 @@
+//go:build !unix
+
+package grpc
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup is a no-op where process groups are unsupported
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// signalProcessGroup falls back to signalling only p itself
+func signalProcessGroup(p *os.Process, sig syscall.Signal) error {
+	if sig == syscall.SIGKILL {
+		return p.Kill()
+	}
+	return p.Signal(sig)
+}
+
+// processAlive assumes the process exists where signal 0 is unsupported
+func processAlive(pid int) bool {
+	return true
+}
@@ -0,0 +1,36 @@
+//go:build unix
+
+package grpc
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup starts the command in its own process group so signals
+// reach any children it spawns
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+}
+
+// signalProcessGroup delivers sig to every process in p's process group
+func signalProcessGroup(p *os.Process, sig syscall.Signal) error {
+	if err := syscall.Kill(-p.Pid, sig); err != nil {
+		if err == syscall.ESRCH {
+			return os.ErrProcessDone
+		}
+		return err
+	}
+	return nil
+}
+
+// processAlive reports whether a process with pid exists, using signal 0,
+// and has not exited as a zombie
+func processAlive(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return (err == nil || err == syscall.EPERM) && !isZombie(pid)
+}
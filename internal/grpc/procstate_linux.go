@@ -0,0 +1,20 @@
+package grpc
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+)
+
+// isZombie reports whether pid has exited but was not waited for yet, which
+// signal 0 cannot tell from a running process
+func isZombie(pid int) bool {
+	stat, err := os.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if err != nil {
+		return false
+	}
+	// The state follows the command name, which is in parentheses and may
+	// itself contain them
+	i := bytes.LastIndexByte(stat, ')')
+	return i >= 0 && i+2 < len(stat) && stat[i+2] == 'Z'
+}
@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"regexp"
+	"strconv"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// progressGroup is the named capture a progress pattern must define
+const progressGroup = "percent"
+
+// compileProgressPattern compiles a request's progress pattern, returning
+// nil when none was given
+func compileProgressPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, invalidField("progress_pattern", "invalid progress_pattern: %v", err)
+	}
+	if re.SubexpIndex(progressGroup) < 0 {
+		return nil, invalidField("progress_pattern", "progress_pattern must have a named capture (?P<%s>...)", progressGroup)
+	}
+	return re, nil
+}
+
+// withProgress wraps obs so output lines matching pattern are reported as
+// progress events instead of output lines
+func withProgress(obs *observer, pattern *regexp.Regexp) *observer {
+	if pattern == nil || obs.progress == nil {
+		return obs
+	}
+
+	group := pattern.SubexpIndex(progressGroup)
+	wrapped := *obs
+	wrapped.line = func(line string, isStdout bool) {
+		if match := pattern.FindStringSubmatch(line); match != nil {
+			if percent, err := strconv.ParseFloat(match[group], 64); err == nil {
+				obs.progress(&executorv1.ProgressEvent{
+					Percent: min(max(percent, 0), 100),
+					Line:    line,
+					Stderr:  !isStdout,
+				})
+				return
+			}
+		}
+
+		if obs.line != nil {
+			obs.line(line, isStdout)
+		}
+	}
+	return &wrapped
+}
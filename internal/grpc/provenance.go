@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// gitSHATimeout bounds the git rev-parse run for capture_git_sha
+const gitSHATimeout = 10 * time.Second
+
+// gitSHA returns the HEAD commit of the git work tree at dir, or "" when dir
+// is not in a git repository or git is unavailable
+func (s *ExecutorServer) gitSHA(ctx context.Context, dir string) string {
+	ctx, cancel := context.WithTimeout(ctx, gitSHATimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "HEAD")
+	cmd.Dir = dir
+	cmd.Env = s.environ(cmd)
+
+	output, err := cmd.Output()
+	if err != nil {
+		s.logger.Debug("could not capture git sha", zap.String("dir", dir), zap.Error(err))
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
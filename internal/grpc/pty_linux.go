@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+	"unsafe"
+)
+
+// ptySupported reports whether ExecuteTerminal can open pseudo-terminals
+const ptySupported = true
+
+// openPTY opens a pseudo-terminal, returning its controlling side and the
+// terminal for the command
+func openPTY() (ptm, tty *os.File, err error) {
+	ptm, err = os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open pseudo-terminal: %w", err)
+	}
+
+	var n uint32
+	err = ptyIoctl(ptm, syscall.TIOCSPTLCK, unsafe.Pointer(new(int32)))
+	if err == nil {
+		err = ptyIoctl(ptm, syscall.TIOCGPTN, unsafe.Pointer(&n))
+	}
+	if err == nil {
+		tty, err = os.OpenFile("/dev/pts/"+strconv.FormatUint(uint64(n), 10), os.O_RDWR|syscall.O_NOCTTY, 0)
+	}
+	if err != nil {
+		ptm.Close()
+		return nil, nil, fmt.Errorf("failed to open pseudo-terminal: %w", err)
+	}
+	return ptm, tty, nil
+}
+
+// setPTYSize sets the window size of the pseudo-terminal, signalling
+// SIGWINCH to its foreground process group
+func setPTYSize(ptm *os.File, rows, cols uint16) error {
+	size := struct{ rows, cols, x, y uint16 }{rows: rows, cols: cols}
+	return ptyIoctl(ptm, syscall.TIOCSWINSZ, unsafe.Pointer(&size))
+}
+
+// ptyIoctl runs an ioctl on f without taking it out of non-blocking mode,
+// which Fd would
+func ptyIoctl(f *os.File, req uintptr, arg unsafe.Pointer) error {
+	conn, err := f.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var errno syscall.Errno
+	err = conn.Control(func(fd uintptr) {
+		_, _, errno = syscall.Syscall(syscall.SYS_IOCTL, fd, req, uintptr(arg))
+	})
+	if err != nil {
+		return err
+	}
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// setControllingTerminal starts cmd in a new session whose controlling
+// terminal is its stdin. The session leader also leads a new process group,
+// so the group is still signalled as a whole.
+func setControllingTerminal(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = false
+	cmd.SysProcAttr.Setsid = true
+	cmd.SysProcAttr.Setctty = true
+	cmd.SysProcAttr.Ctty = 0
+}
@@ -0,0 +1,25 @@
+//go:build !linux
+
+package grpc
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+)
+
+// ptySupported reports whether ExecuteTerminal can open pseudo-terminals
+const ptySupported = false
+
+// openPTY is unsupported outside Linux
+func openPTY() (ptm, tty *os.File, err error) {
+	return nil, nil, errors.ErrUnsupported
+}
+
+// setPTYSize is unsupported outside Linux
+func setPTYSize(ptm *os.File, rows, cols uint16) error {
+	return errors.ErrUnsupported
+}
+
+// setControllingTerminal is unsupported outside Linux
+func setControllingTerminal(cmd *exec.Cmd) {}
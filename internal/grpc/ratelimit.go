@@ -0,0 +1,261 @@
+package grpc
+
+import (
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// rateFlushInterval is how often the lines a limiter held back are flushed
+// and reported, even while the stream is quiet
+const rateFlushInterval = time.Second
+
+// heldLinesSlots is how many lines a limiter can hold back for coalescing
+// between flushes; further excess lines are dropped
+const heldLinesSlots = 1024
+
+// lineLimiter caps the lines of one output stream kept each second,
+// dropping or coalescing the excess. Lines are passed in by the stream's
+// reader and held back ones flushed by a background flusher on a jittered
+// ticker. The reader only takes a lock to deliver lines, which it shares
+// with its own flusher alone.
+type lineLimiter struct {
+	max      int
+	coalesce bool
+	maxHeld  int64 // bytes held for coalescing before lines are dropped
+
+	// Owned by the reader
+	window   time.Time
+	count    int
+	recorded bool
+
+	// Shared with the flusher without locking
+	held     *heldLines
+	heldSize atomic.Int64
+	dropped  atomic.Int64
+
+	// deliverMu serializes delivery, so the stream's capture keeps a single
+	// writer, and makes the holder the only consumer of held
+	deliverMu sync.Mutex
+	deliver   func(string)
+
+	// interval and jitter pace the flusher. Without jitter, flushes are
+	// aligned to multiples of interval.
+	interval time.Duration
+	jitter   bool
+	stop     chan struct{}
+	stopped  chan struct{}
+
+	// limited is called on the first excess line, report when a flush
+	// delivered coalesced lines or found dropped ones
+	limited func()
+	report  func(dropped, coalesced int64)
+}
+
+// newLineLimiter returns a limiter enforcing executor.max_output_lines_per_sec
+// on one stream, delivering the lines it lets through to deliver, or nil if
+// it is not set. The limiter's flusher runs until close is called.
+func (s *ExecutorServer) newLineLimiter(isStdout bool, limits *limitRecorder, obs *observer, deliver func(string)) *lineLimiter {
+	max := s.config.MaxOutputLinesPerSec
+	if max <= 0 {
+		return nil
+	}
+
+	stream := "stderr"
+	if isStdout {
+		stream = "stdout"
+	}
+
+	l := &lineLimiter{
+		max:      max,
+		coalesce: s.config.OutputRatePolicy == "coalesce",
+		maxHeld:  int64(s.config.MaxLineBytes),
+		held:     newHeldLines(heldLinesSlots),
+		deliver:  deliver,
+		interval: rateFlushInterval,
+		jitter:   true,
+	}
+	l.limited = func() {
+		limits.record(executorv1.LimitKind_LIMIT_KIND_OUTPUT_RATE, int64(max), stream)
+	}
+	l.report = func(dropped, coalesced int64) {
+		if obs.rateLimited != nil {
+			obs.rateLimited(&executorv1.RateLimitedEvent{
+				Stderr:         !isStdout,
+				Dropped:        dropped,
+				Coalesced:      coalesced,
+				MaxLinesPerSec: int32(max),
+			})
+		}
+	}
+	l.start()
+	return l
+}
+
+// start runs the flusher
+func (l *lineLimiter) start() {
+	l.stop = make(chan struct{})
+	l.stopped = make(chan struct{})
+	go l.flushPeriodically()
+}
+
+// line delivers line unless the stream is over its rate
+func (l *lineLimiter) line(line string) {
+	now := time.Now()
+	if now.Sub(l.window) >= time.Second {
+		l.window = now
+		l.count = 0
+
+		// Lines held back in the ending second go out before the new one's
+		if l.pending() {
+			l.deliverMu.Lock()
+			l.flushLocked()
+			l.deliverMu.Unlock()
+		}
+	}
+	if l.count < l.max {
+		l.count++
+		l.deliverMu.Lock()
+		l.deliver(line)
+		l.deliverMu.Unlock()
+		return
+	}
+
+	if !l.recorded {
+		l.recorded = true
+		l.limited()
+	}
+	size := int64(len(line) + 1)
+	if l.coalesce && l.heldSize.Load()+size <= l.maxHeld && l.held.push(line) {
+		l.heldSize.Add(size)
+		return
+	}
+	l.dropped.Add(1)
+}
+
+// pending reports whether lines were held back or dropped since the last
+// flush
+func (l *lineLimiter) pending() bool {
+	return l.held.len() > 0 || l.dropped.Load() > 0
+}
+
+// flushPeriodically flushes on a jittered ticker until close is called
+func (l *lineLimiter) flushPeriodically() {
+	defer close(l.stopped)
+
+	timer := time.NewTimer(l.nextFlush(true))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-timer.C:
+			if l.pending() {
+				l.deliverMu.Lock()
+				l.flushLocked()
+				l.deliverMu.Unlock()
+			}
+			timer.Reset(l.nextFlush(false))
+		}
+	}
+}
+
+// nextFlush returns the time until the next flush. A jittered limiter
+// flushes first at a random point of the interval and then moves each flush
+// by up to a tenth of it either way, so the flushes of many concurrent
+// streams spread out instead of hitting the transport at the same instant.
+func (l *lineLimiter) nextFlush(first bool) time.Duration {
+	if !l.jitter {
+		return l.interval - time.Duration(time.Now().UnixNano())%l.interval
+	}
+	if first {
+		return rand.N(l.interval) + 1
+	}
+	jitter := l.interval / 10
+	return l.interval - jitter + rand.N(2*jitter+1)
+}
+
+// flushLocked delivers the held back lines as one line and reports what
+// was held back. l.deliverMu must be held.
+func (l *lineLimiter) flushLocked() {
+	held, size := l.held.drain()
+	l.heldSize.Add(-size)
+	dropped := l.dropped.Swap(0)
+
+	coalesced := int64(len(held))
+	if coalesced > 0 {
+		l.deliver(strings.Join(held, "\n"))
+	}
+	if coalesced > 0 || dropped > 0 {
+		l.report(dropped, coalesced)
+	}
+}
+
+// close stops the flusher and flushes what is left, once the stream ended.
+// A nil limiter does nothing.
+func (l *lineLimiter) close() {
+	if l == nil {
+		return
+	}
+	close(l.stop)
+	<-l.stopped
+
+	l.deliverMu.Lock()
+	defer l.deliverMu.Unlock()
+	l.flushLocked()
+}
+
+// heldLines is a lock-free ring of lines written by a single producer and
+// drained by a single consumer at a time
+type heldLines struct {
+	slots []string
+	mask  uint64
+	head  atomic.Uint64 // next slot to drain
+	tail  atomic.Uint64 // next slot to fill
+}
+
+// newHeldLines returns a ring of n slots, n being a power of two
+func newHeldLines(n int) *heldLines {
+	return &heldLines{slots: make([]string, n), mask: uint64(n - 1)}
+}
+
+// push appends line, reporting false if the ring is full
+func (h *heldLines) push(line string) bool {
+	tail := h.tail.Load()
+	if tail-h.head.Load() == uint64(len(h.slots)) {
+		return false
+	}
+	h.slots[tail&h.mask] = line
+	h.tail.Store(tail + 1)
+	return true
+}
+
+// len returns the number of lines held
+func (h *heldLines) len() int {
+	return int(h.tail.Load() - h.head.Load())
+}
+
+// drain removes and returns the lines held, with their size as counted by
+// lineLimiter.line
+func (h *heldLines) drain() ([]string, int64) {
+	head, tail := h.head.Load(), h.tail.Load()
+	if head == tail {
+		return nil, 0
+	}
+
+	lines := make([]string, 0, tail-head)
+	var size int64
+	for i := head; i != tail; i++ {
+		slot := &h.slots[i&h.mask]
+		lines = append(lines, *slot)
+		size += int64(len(*slot) + 1)
+		*slot = ""
+	}
+	h.head.Store(tail)
+	return lines, size
+}
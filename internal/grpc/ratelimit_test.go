@@ -0,0 +1,152 @@
+package grpc
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testLimiter returns a running coalescing limiter keeping max lines a
+// second and flushing every interval
+func testLimiter(max int, interval time.Duration, jitter bool, deliver func(string)) (*lineLimiter, *atomic.Int64) {
+	var coalesced atomic.Int64
+	l := &lineLimiter{
+		max:      max,
+		coalesce: true,
+		maxHeld:  1 << 20,
+		held:     newHeldLines(heldLinesSlots),
+		deliver:  deliver,
+		interval: interval,
+		jitter:   jitter,
+		limited:  func() {},
+		report:   func(_, n int64) { coalesced.Add(n) },
+	}
+	l.start()
+	return l, &coalesced
+}
+
+func TestLineLimiterCoalescesInOrder(t *testing.T) {
+	var got []string
+	l, coalesced := testLimiter(2, time.Hour, true, func(line string) {
+		got = append(got, line)
+	})
+
+	for i := range 5 {
+		l.line(fmt.Sprint(i))
+	}
+	l.close()
+
+	want := []string{"0", "1", "2\n3\n4"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("delivered %q, want %q", got, want)
+	}
+	if n := coalesced.Load(); n != 3 {
+		t.Fatalf("reported %d coalesced lines, want 3", n)
+	}
+}
+
+func TestLineLimiterFlushesWhileQuiet(t *testing.T) {
+	var mu sync.Mutex
+	var got []string
+	l, _ := testLimiter(1, 10*time.Millisecond, true, func(line string) {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, line)
+	})
+	defer l.close()
+
+	l.line("kept")
+	l.line("held")
+
+	waitFor(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(got) == 2
+	})
+	if got[1] != "held" {
+		t.Fatalf("flushed %q, want %q", got[1], "held")
+	}
+}
+
+func TestHeldLinesFull(t *testing.T) {
+	h := newHeldLines(4)
+	for i := range 4 {
+		if !h.push(fmt.Sprint(i)) {
+			t.Fatalf("push %d failed on a ring with room", i)
+		}
+	}
+	if h.push("4") {
+		t.Fatal("push succeeded on a full ring")
+	}
+
+	lines, size := h.drain()
+	if len(lines) != 4 || size != 8 {
+		t.Fatalf("drained %q of size %d, want 4 lines of size 8", lines, size)
+	}
+	if !h.push("5") {
+		t.Fatal("push failed after draining")
+	}
+}
+
+// BenchmarkLineLimiter runs 100 rate limited streams writing to one shared
+// transport, comparing flushes aligned across streams with jittered ones.
+// wait-ns/flush is how long a flush waited for the transport.
+func BenchmarkLineLimiter(b *testing.B) {
+	const streams = 100
+
+	for _, jitter := range []bool{false, true} {
+		name := "aligned"
+		if jitter {
+			name = "jittered"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			var transport sync.Mutex
+			var waited, flushes atomic.Int64
+			send := func(line string) {
+				start := time.Now()
+				transport.Lock()
+				locked := time.Now()
+				waited.Add(int64(locked.Sub(start)))
+				// Stand in for encoding and writing the message
+				for time.Since(locked) < 20*time.Microsecond {
+				}
+				transport.Unlock()
+			}
+
+			limiters := make([]*lineLimiter, streams)
+			for i := range limiters {
+				limiters[i], _ = testLimiter(1, time.Millisecond, jitter, func(line string) {
+					flushes.Add(1)
+					send(line)
+				})
+			}
+
+			b.ResetTimer()
+			var wg sync.WaitGroup
+			for _, l := range limiters {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := range b.N {
+						l.line("line")
+						if i%64 == 0 {
+							time.Sleep(100 * time.Microsecond)
+						}
+					}
+				}()
+			}
+			wg.Wait()
+			for _, l := range limiters {
+				l.close()
+			}
+			b.StopTimer()
+
+			if n := flushes.Load(); n > 0 {
+				b.ReportMetric(float64(waited.Load())/float64(n), "wait-ns/flush")
+			}
+		})
+	}
+}
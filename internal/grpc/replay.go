@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"sync"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// pipelineLog holds the events sent by an ExecutePipelineStream call
+type pipelineLog struct {
+	tenant      string // tenant that ran the pipeline
+	mu          sync.Mutex
+	events      []*executorv1.PipelineStreamResponse
+	dropped     int
+	completedAt time.Time // zero while the pipeline runs
+}
+
+// replayStream records the events sent to a pipeline stream
+type replayStream struct {
+	executorv1.ExecutorService_ExecutePipelineStreamServer
+	log       *pipelineLog
+	maxEvents int
+}
+
+// Send implements executorv1.ExecutorService_ExecutePipelineStreamServer
+func (r *replayStream) Send(msg *executorv1.PipelineStreamResponse) error {
+	r.log.mu.Lock()
+	if len(r.log.events) < r.maxEvents || msg.GetPipelineCompleted() != nil {
+		r.log.events = append(r.log.events, proto.Clone(msg).(*executorv1.PipelineStreamResponse))
+	} else {
+		r.log.dropped++
+	}
+	r.log.mu.Unlock()
+	return r.ExecutorService_ExecutePipelineStreamServer.Send(msg)
+}
+
+// recordPipeline returns stream recording its events for ReplayPipeline and
+// a function to call once the pipeline finished. stream is returned
+// unchanged if executor.pipeline_replay is disabled.
+func (s *ExecutorServer) recordPipeline(pipelineID string, stream executorv1.ExecutorService_ExecutePipelineStreamServer) (executorv1.ExecutorService_ExecutePipelineStreamServer, func()) {
+	cfg := s.config.PipelineReplay
+	if !cfg.Enabled {
+		return stream, func() {}
+	}
+
+	log := &pipelineLog{tenant: callerTenant(stream.Context())}
+	s.replaysMu.Lock()
+	s.replays[pipelineID] = log
+	s.replaysMu.Unlock()
+
+	return &replayStream{ExecutorService_ExecutePipelineStreamServer: stream, log: log, maxEvents: cfg.MaxEvents}, func() {
+		log.mu.Lock()
+		log.completedAt = time.Now()
+		if log.dropped > 0 {
+			s.logger.Warn("pipeline events not recorded for replay",
+				zap.String("pipeline_id", pipelineID),
+				zap.Int("dropped", log.dropped),
+			)
+		}
+		log.mu.Unlock()
+		s.evictReplays()
+	}
+}
+
+// evictReplays drops logs of pipelines that finished longer than
+// executor.pipeline_replay.retention ago, then the oldest finished ones
+// beyond max_pipelines
+func (s *ExecutorServer) evictReplays() {
+	cfg := s.config.PipelineReplay
+	now := time.Now()
+	retention := time.Duration(cfg.Retention) * time.Second
+
+	s.replaysMu.Lock()
+	defer s.replaysMu.Unlock()
+
+	finished := make(map[string]time.Time)
+	for id, log := range s.replays {
+		log.mu.Lock()
+		completedAt := log.completedAt
+		log.mu.Unlock()
+
+		switch {
+		case completedAt.IsZero():
+		case now.Sub(completedAt) > retention:
+			delete(s.replays, id)
+		default:
+			finished[id] = completedAt
+		}
+	}
+
+	for len(finished) > cfg.MaxPipelines {
+		var oldest string
+		for id, completedAt := range finished {
+			if oldest == "" || completedAt.Before(finished[oldest]) {
+				oldest = id
+			}
+		}
+		delete(finished, oldest)
+		delete(s.replays, oldest)
+	}
+}
+
+// ReplayPipeline re-sends the recorded events of a pipeline stream in order.
+// For a running pipeline the events recorded so far are sent.
+func (s *ExecutorServer) ReplayPipeline(req *executorv1.ReplayPipelineRequest, stream executorv1.ExecutorService_ReplayPipelineServer) error {
+	if !s.config.PipelineReplay.Enabled {
+		return status.Error(codes.FailedPrecondition, "pipeline replay is disabled")
+	}
+	if req.PipelineId == "" {
+		return invalidField("pipeline_id", "pipeline_id is required")
+	}
+
+	s.replaysMu.Lock()
+	log, ok := s.replays[req.PipelineId]
+	s.replaysMu.Unlock()
+	if !ok || log.tenant != callerTenant(stream.Context()) {
+		return status.Errorf(codes.NotFound, "no recorded events for pipeline %s", req.PipelineId)
+	}
+
+	log.mu.Lock()
+	events := append([]*executorv1.PipelineStreamResponse{}, log.events...)
+	log.mu.Unlock()
+
+	for _, event := range events {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
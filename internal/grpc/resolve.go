@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// toolVersionUnknown is reported for a pinned tool not checked yet
+const toolVersionUnknown = "unknown"
+
+// ResolveRequest validates and resolves req the way Execute does and
+// returns what would run, without creating directories, fetching secrets
+// or starting anything, not even a tool's version check. Only the allowlist
+// is checked, as compiled-in validators may have side effects.
+func (s *ExecutorServer) ResolveRequest(ctx context.Context, req *executorv1.ExecuteRequest) (*executorv1.ResolveResponse, error) {
+	req, err := s.resolveShell(req)
+	if err != nil {
+		return nil, err
+	}
+	req, releaseWorkspace, err := s.resolveWorkspace(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	releaseWorkspace()
+
+	if err := s.checkAllowed(req.Tool); err != nil {
+		return nil, err
+	}
+	toolVersion, checked, err := s.cachedToolVersion(req.Tool)
+	if err != nil {
+		return nil, err
+	}
+	if !checked {
+		toolVersion = toolVersionUnknown
+	}
+	if _, err := compileProgressPattern(req.ProgressPattern); err != nil {
+		return nil, err
+	}
+	if _, err := compileStopPattern(req.StopOnPattern); err != nil {
+		return nil, err
+	}
+	filter, err := s.outputFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	createsWorkDir := false
+	if req.CreateWorkDir && req.WorkDir != "" {
+		_, err := os.Stat(req.WorkDir)
+		createsWorkDir = os.IsNotExist(err)
+	}
+	if !createsWorkDir {
+		if err := checkWorkDir(req.WorkDir); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	// The process ID is only known once it runs
+	placeholder := "${" + templateProcessID + "}"
+	vars := s.templateVars("", nil)
+	delete(vars, templateProcessID)
+	literalArgs := s.withDefaultArgs(req.Tool, req.Args)
+	args := expandTemplates(literalArgs, vars)
+	env := expandTemplates(req.Env, vars)
+
+	cmd := exec.Command(req.Tool)
+	cmd.Dir = req.WorkDir
+	merged := mergeEnv(s.environ(cmd), s.contextEnv(placeholder, nil), env)
+
+	timeout := time.Duration(s.config.DefaultTimeout) * time.Second
+	if req.TimeoutSeconds > 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
+
+	response := &executorv1.ResolveResponse{
+		Tool:                 req.Tool,
+		Args:                 args,
+		WorkDir:              req.WorkDir,
+		CreatesWorkDir:       createsWorkDir,
+		Env:                  make([]*executorv1.EnvVar, 0, len(merged)),
+		TimeoutSeconds:       int32(timeout / time.Second),
+		MaxLifetimeSeconds:   int32(s.maxLifetime(req) / time.Second),
+		ConcurrencyWeight:    int32(s.toolWeight(req.Tool)),
+		MaxOutputBytes:       s.config.MaxOutputBytes,
+		MaxOutputLinesPerSec: int32(s.config.MaxOutputLinesPerSec),
+		SecretRefs:           secretRefs(literalArgs, req.Env),
+		ToolVersion:          toolVersion,
+	}
+	if response.MaxOutputLinesPerSec > 0 {
+		response.OutputRatePolicy = s.config.OutputRatePolicy
+	}
+	response.FastPath = s.fastPath(req, false, len(response.SecretRefs) > 0 || filter != nil)
+	if filter != nil {
+		response.OutputFilters = filter.names
+	}
+	if path, err := exec.LookPath(req.Tool); err == nil {
+		response.ToolPath = path
+	}
+
+	for _, kv := range merged {
+		key, value, _ := strings.Cut(kv, "=")
+		v := &executorv1.EnvVar{Key: key, Value: value}
+		if s.redactEnvKey(key) {
+			v.Value = ""
+			v.Redacted = true
+		}
+		response.Env = append(response.Env, v)
+	}
+	return response, nil
+}
+
+// secretRefs returns the ${secret:<ref>} references in values, in order of
+// first appearance
+func secretRefs(values ...[]string) []string {
+	var refs []string
+	seen := make(map[string]bool)
+	for _, list := range values {
+		for _, value := range list {
+			for _, match := range secretPattern.FindAllStringSubmatch(value, -1) {
+				if !seen[match[1]] {
+					seen[match[1]] = true
+					refs = append(refs, match[1])
+				}
+			}
+		}
+	}
+	return refs
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"github.com/knullci/necrosword/internal/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolveRequestRunsNoVersionCheck(t *testing.T) {
+	marker := filepath.Join(t.TempDir(), "checked")
+	s := newTestServer(t, func(cfg *config.ExecutorConfig) {
+		cfg.ToolVersions = map[string]config.ToolVersionConfig{
+			"sh": {Args: []string{"-c", "touch " + marker + " && echo 1.2.3"}, Pattern: `^1\.2\.`},
+		}
+	})
+	req := &executorv1.ExecuteRequest{Tool: "sh", Args: []string{"-c", "true"}}
+
+	response, err := s.ResolveRequest(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ResolveRequest: %v", err)
+	}
+	if response.ToolVersion != toolVersionUnknown {
+		t.Errorf("tool version = %q, want %q", response.ToolVersion, toolVersionUnknown)
+	}
+	if _, err := os.Stat(marker); err == nil {
+		t.Fatal("ResolveRequest ran the version check")
+	}
+
+	// Once an execution checked it, the preview reports the cached version
+	if err := s.checkToolVersion(context.Background(), "sh"); err != nil {
+		t.Fatalf("checkToolVersion: %v", err)
+	}
+	response, err = s.ResolveRequest(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ResolveRequest: %v", err)
+	}
+	if response.ToolVersion != "1.2.3" {
+		t.Errorf("tool version = %q, want %q", response.ToolVersion, "1.2.3")
+	}
+}
+
+func TestResolveRequestReportsCachedVersionMismatch(t *testing.T) {
+	s := newTestServer(t, func(cfg *config.ExecutorConfig) {
+		cfg.ToolVersions = map[string]config.ToolVersionConfig{
+			"sh": {Args: []string{"-c", "echo 0.9"}, Pattern: `^1\.`},
+		}
+	})
+	if err := s.checkToolVersion(context.Background(), "sh"); err == nil {
+		t.Fatal("checkToolVersion accepted a mismatching version")
+	}
+
+	_, err := s.ResolveRequest(context.Background(), &executorv1.ExecuteRequest{Tool: "sh"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ResolveRequest error = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestResolveRequestChecksAllowlist(t *testing.T) {
+	s := newTestServer(t, nil)
+
+	_, err := s.ResolveRequest(context.Background(), &executorv1.ExecuteRequest{Tool: "rm"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ResolveRequest error = %v, want PermissionDenied", err)
+	}
+}
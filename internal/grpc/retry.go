@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// maxRetryDelay caps the exponential backoff between step attempts
+const maxRetryDelay = 10 * time.Minute
+
+// shouldRetry reports whether a failed step attempt may be retried. Only
+// non-zero exit codes are retried, limited to retry_on_exit_codes when set.
+func shouldRetry(ctx context.Context, step *executorv1.BuildStep, response *executorv1.ExecuteResponse) bool {
+	if response.Success || response.TimedOut || response.ExitCode <= 0 || ctx.Err() != nil {
+		return false
+	}
+	if len(step.RetryOnExitCodes) > 0 {
+		return slices.Contains(step.RetryOnExitCodes, response.ExitCode)
+	}
+	return true
+}
+
+// retryDelay returns the backoff before the attempt following attempt n,
+// doubling retry_backoff_seconds each time
+func retryDelay(step *executorv1.BuildStep, n int32) time.Duration {
+	delay := time.Duration(step.RetryBackoffSeconds) * time.Second
+	for i := int32(1); i < n && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
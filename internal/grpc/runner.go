@@ -0,0 +1,725 @@
+package grpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// osPipe creates the pipes a command's output is read from. Tests replace it
+// to make pipe creation fail.
+var osPipe = os.Pipe
+
+// pipelineScope identifies the pipeline step an execution belongs to
+type pipelineScope struct {
+	pipelineID string
+	stepName   string
+	stepIndex  int
+	gitSHA     string
+
+	// outputs are the values captured by earlier steps, shared by all steps
+	// of the pipeline
+	outputs map[string]string
+}
+
+// run executes req to completion and builds its response. It is shared by the
+// unary, streaming and pipeline paths so every execution is resolved the same
+// way. obs may be nil. An error is only returned when the process could not
+// be started.
+func (s *ExecutorServer) run(ctx context.Context, req *executorv1.ExecuteRequest, scope *pipelineScope, obs *observer) (*executorv1.ExecuteResponse, error) {
+	if obs == nil {
+		obs = &observer{}
+	}
+
+	progress, err := compileProgressPattern(req.ProgressPattern)
+	if err != nil {
+		return nil, err
+	}
+	obs = withProgress(obs, progress)
+
+	stopPattern, err := compileStopPattern(req.StopOnPattern)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := s.outputFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkWorkDir(req.WorkDir); err != nil {
+		return nil, err
+	}
+	if err := s.checkRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	queuedAt := time.Now()
+	release, err := s.acquireSlot(ctx, req.Tool)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	admittedAt := time.Now()
+
+	// Create timeout context
+	timeout := time.Duration(s.config.DefaultTimeout) * time.Second
+	if req.TimeoutSeconds > 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	processID := s.ids.newID()
+	runningProc := &RunningProcess{
+		ID:      processID,
+		Tool:    req.Tool,
+		Cancel:  cancel,
+		Tenant:  callerTenant(ctx),
+		logArgs: s.shouldLogArgs(req.Tool, req.LogArgs),
+		waited:  make(chan struct{}),
+	}
+	if scope != nil {
+		runningProc.PipelineID = scope.pipelineID
+	}
+
+	// Resolve template variables in args and env, then secrets, which are
+	// masked wherever args and output are shown
+	vars := s.templateVars(processID, scope)
+	var secrets []string
+	args, err := s.resolveSecrets(ctx, "args", s.withDefaultArgs(req.Tool, req.Args), vars, &secrets)
+	if err != nil {
+		return nil, err
+	}
+	env, err := s.resolveSecrets(ctx, "env", req.Env, vars, &secrets)
+	if err != nil {
+		return nil, err
+	}
+	mask := newMasker(secrets)
+	runningProc.Args = mask.maskAll(args)
+
+	// Build command
+	cmd := s.command(ctx, runningProc, req.Tool, args)
+
+	if req.WorkDir != "" {
+		cmd.Dir = req.WorkDir
+	}
+
+	var homeEnv []string
+	if req.IsolateHome {
+		env, remove, err := s.isolatedHome(processID)
+		if err != nil {
+			return nil, err
+		}
+		defer remove()
+		homeEnv = env
+	}
+
+	cmd.Env = mergeEnv(s.environ(cmd), homeEnv, s.contextEnv(processID, scope), env)
+	if err := s.isolate(cmd); err != nil {
+		return nil, err
+	}
+
+	// Capture output. The fast path hands captures straight to the command,
+	// skipping line scanning, when no one is watching the output live.
+	limits := &limitRecorder{notify: obs.limit}
+	stdoutBuf := s.newCapture(processID, "stdout", req.TailLines, req.CaptureStdout, limits)
+	stderrBuf := s.newCapture(processID, "stderr", req.TailLines, req.CaptureStderr, limits)
+	if req.HashOutput && !stdoutBuf.discard {
+		stdoutBuf.hash = sha256.New()
+	}
+	if req.HashStderr && !stderrBuf.discard {
+		stderrBuf.hash = sha256.New()
+	}
+	stdoutBuf.mask, stderrBuf.mask = mask, mask
+	stdoutBuf.filter, stderrBuf.filter = filter, filter
+	runningProc.stdout, runningProc.stderr = stdoutBuf, stderrBuf
+
+	fastPath := s.fastPath(req, obs.line != nil, mask != nil || filter != nil)
+	var stdout, stderr io.Reader
+	var childFiles []*os.File
+
+	// Combined output shares the stdout capture or pipe
+	combined := req.OutputMode == executorv1.OutputMode_OUTPUT_MODE_COMBINED
+
+	var stoppedOnPattern atomic.Bool
+	var output activity
+	readObs := obs
+	if fastPath {
+		cmd.Stdout = stdoutBuf
+		cmd.Stderr = stderrBuf
+		if combined {
+			cmd.Stderr = stdoutBuf
+		}
+	} else {
+		// Lines read are also published to TailProcess subscribers
+		runningProc.hub = newOutputHub(s.config.TailBacklogLines, s.config.TailQueueLines)
+		defer runningProc.hub.finish(nil)
+		readObs = withPublish(obs, runningProc.hub)
+		if s.forwarder != nil {
+			readObs = s.forwarder.observe(readObs, forwardLabels(processID, req.Tool, scope))
+		}
+		readObs = withActivity(readObs, &output)
+		if stopPattern != nil {
+			readObs = withStopPattern(readObs, stopPattern, func() {
+				stoppedOnPattern.Store(true)
+				runningProc.Terminate(s.cancelSignal)
+			})
+		}
+		readObs = withMask(readObs, mask)
+
+		// Pipes are created here rather than with StdoutPipe so every failure
+		// path can release them
+		stdoutR, stdoutW, err := osPipe()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout pipe: %w", err)
+		}
+
+		defer closeFiles(stdoutR)
+
+		cmd.Stdout = stdoutW
+		cmd.Stderr = stdoutW
+		childFiles = []*os.File{stdoutW}
+		stdout = &countingReader{r: stdoutR, n: &stdoutBuf.written}
+
+		if !combined {
+			stderrR, stderrW, err := osPipe()
+			if err != nil {
+				closeFiles(stdoutW)
+				return nil, fmt.Errorf("failed to create stderr pipe: %w", err)
+			}
+			defer closeFiles(stderrR)
+
+			cmd.Stderr = stderrW
+			childFiles = append(childFiles, stderrW)
+			stderr = &countingReader{r: stderrR, n: &stderrBuf.written}
+		}
+	}
+
+	// Start command
+	startTime := time.Now()
+
+	cmd, startAttempts, err := s.startCommand(ctx, cmd, runningProc)
+
+	// The child has its own copies of the write ends now; ours must be
+	// closed for reads to see EOF, and are not needed if the start failed
+	closeFiles(childFiles...)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to start command: %w", err)
+	}
+	runningAt := time.Now()
+	s.applyPriority(runningProc, req)
+
+	// Track running process
+	runningProc.StartedAt = startTime
+
+	s.mu.Lock()
+	s.running[processID] = runningProc
+	s.mu.Unlock()
+
+	if obs.started != nil {
+		obs.started(processID)
+	}
+	if req.EmitEnvSnapshot && obs.env != nil {
+		if snapshot := s.envSnapshot(processID, mask.maskAll(cmd.Env)); snapshot != nil {
+			obs.env(snapshot)
+		}
+	}
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.running, processID)
+		s.mu.Unlock()
+	}()
+
+	// The lifetime cap applies however the process behaves, so it is
+	// enforced apart from the timeout context
+	var lifetimeExceeded atomic.Bool
+	lifetime := s.maxLifetime(req)
+	if lifetime > 0 {
+		timer := time.AfterFunc(lifetime, func() {
+			lifetimeExceeded.Store(true)
+			runningProc.Terminate(s.cancelSignal)
+		})
+		defer timer.Stop()
+	}
+
+	// Idle time counts from the start, until the first output
+	var idleTimedOut atomic.Bool
+	if req.IdleTimeoutSeconds > 0 {
+		output.touch()
+		stopWatching := watchIdle(&output, time.Duration(req.IdleTimeoutSeconds)*time.Second, func() {
+			idleTimedOut.Store(true)
+			runningProc.Terminate(s.cancelSignal)
+		})
+		defer stopWatching()
+	}
+
+	// Read output
+	var wg sync.WaitGroup
+	if !fastPath {
+		read := s.readOutput
+		if req.OutputMode == executorv1.OutputMode_OUTPUT_MODE_RAW_CHUNKS {
+			read = s.readChunks
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			read(stdout, stdoutBuf, true, limits, readObs)
+		}()
+		if stderr != nil {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				read(stderr, stderrBuf, false, limits, readObs)
+			}()
+		}
+	}
+
+	stopHeartbeat := s.startHeartbeat(ctx, runningProc, obs)
+
+	wg.Wait()
+
+	// A child that closed its output but does not exit is killed after
+	// wait_timeout. Fast-path output closes only with the process, so it is
+	// not bounded.
+	var waitTimedOut atomic.Bool
+	if !fastPath && s.config.WaitTimeout > 0 {
+		timer := time.AfterFunc(time.Duration(s.config.WaitTimeout)*time.Second, func() {
+			waitTimedOut.Store(true)
+			signalProcessGroup(cmd.Process, syscall.SIGKILL)
+		})
+		defer timer.Stop()
+	}
+
+	// Wait for command
+	err = cmd.Wait()
+	runningProc.markExited()
+	stopHeartbeat()
+	endTime := time.Now()
+	duration := endTime.Sub(startTime)
+
+	// Streamed output has already been delivered in full, so its spill files
+	// are not kept
+	stdoutFile, stderrFile := s.closeSpill(stdoutBuf), s.closeSpill(stderrBuf)
+	if obs.line != nil {
+		removeSpillFiles(stdoutFile, stderrFile)
+		stdoutFile, stderrFile = "", ""
+	}
+
+	response := &executorv1.ExecuteResponse{
+		ProcessId:   processID,
+		OsPid:       int32(cmd.Process.Pid),
+		Tool:        req.Tool,
+		Args:        runningProc.Args,
+		Stdout:      stdoutBuf.String(),
+		Stderr:      stderrBuf.String(),
+		DurationMs:  duration.Milliseconds(),
+		StartedAt:   timestamppb.New(startTime),
+		EndedAt:     timestamppb.New(endTime),
+		LimitsHit:   limits.list(),
+		StdoutBytes: stdoutBuf.written.Load(),
+		StderrBytes: stderrBuf.written.Load(),
+		StdoutFile:  stdoutFile,
+		StderrFile:  stderrFile,
+		QueueWaitMs: admittedAt.Sub(queuedAt).Milliseconds(),
+		StartupMs:   runningAt.Sub(admittedAt).Milliseconds(),
+		RunMs:       endTime.Sub(runningAt).Milliseconds(),
+
+		StartAttempts: startAttempts,
+	}
+	response.OutputSha256, response.OutputHashPartial = stdoutBuf.sum()
+	response.StderrSha256, response.StderrHashPartial = stderrBuf.sum()
+
+	if stoppedOnPattern.Load() {
+		// Stopping the process was the goal, however it exited
+		response.StoppedOnPattern = true
+		response.ExitCode = 0
+		response.Success = true
+	} else if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			response.ExitCode = -1
+			response.Error = "command timed out"
+			response.TimedOut = true
+		} else if idleTimedOut.Load() {
+			response.ExitCode = -1
+			response.Error = fmt.Sprintf("process wrote no output for %ds", req.IdleTimeoutSeconds)
+			response.ErrorCode = executorv1.ErrorCode_ERROR_CODE_IDLE_TIMEOUT
+		} else if lifetimeExceeded.Load() {
+			response.ExitCode = -1
+			response.Error = fmt.Sprintf("process exceeded its maximum lifetime of %s", lifetime)
+			response.ErrorCode = executorv1.ErrorCode_ERROR_CODE_MAX_LIFETIME
+		} else if waitTimedOut.Load() {
+			response.ExitCode = -1
+			response.Error = fmt.Sprintf("process did not exit within %ds after closing its output", s.config.WaitTimeout)
+			response.ErrorCode = executorv1.ErrorCode_ERROR_CODE_WAIT_TIMEOUT
+		} else if exitErr, ok := err.(*exec.ExitError); ok {
+			response.ExitCode = int32(exitErr.ExitCode())
+			response.Error = exitErr.Error()
+		} else {
+			response.ExitCode = -1
+			response.Error = err.Error()
+		}
+		response.Success = false
+	} else {
+		response.ExitCode = 0
+		response.Success = true
+	}
+
+	// A non-zero exit may be expected, and zero then unexpected
+	if len(req.ExpectedExitCodes) > 0 && !response.TimedOut && response.ExitCode >= 0 {
+		response.Success = slices.Contains(req.ExpectedExitCodes, response.ExitCode)
+		if response.Success {
+			response.Error = ""
+		} else if err == nil {
+			response.Error = fmt.Sprintf("exit code %d is not in expected_exit_codes %v", response.ExitCode, req.ExpectedExitCodes)
+		}
+	}
+
+	checkSuccessFile(req, response)
+
+	s.runPostExecHook(ctx, req, response)
+
+	fields := []zap.Field{
+		zap.String("process_id", processID),
+		zap.String("tool", req.Tool),
+	}
+	fields = append(fields, argsFields(runningProc.Args, runningProc.logArgs)...)
+	fields = append(fields,
+		zap.Int32("exit_code", response.ExitCode),
+		zap.Duration("duration", duration),
+		zap.Bool("success", response.Success),
+	)
+	s.logger.Info("command executed", fields...)
+
+	if runningProc.hub != nil {
+		runningProc.hub.finish(response)
+	}
+	return response, nil
+}
+
+// checkRequest validates the fields of req checked before it runs, other
+// than its working directory's existence
+func (s *ExecutorServer) checkRequest(ctx context.Context, req *executorv1.ExecuteRequest) error {
+	if err := validatePriority(req); err != nil {
+		return err
+	}
+	if req.TailLines < 0 {
+		return invalidField("tail_lines", "tail_lines must not be negative")
+	}
+	if err := s.checkTenantDir(ctx, "work_dir", req.WorkDir); err != nil {
+		return err
+	}
+	if req.SuccessFile != "" && !filepath.IsLocal(req.SuccessFile) {
+		return invalidField("success_file", "success_file must be a relative path inside work_dir")
+	}
+	return s.checkRawChunks(req)
+}
+
+// checkRawChunks rejects output options that work on lines, which
+// OUTPUT_MODE_RAW_CHUNKS does not split its output into
+func (s *ExecutorServer) checkRawChunks(req *executorv1.ExecuteRequest) error {
+	if req.OutputMode != executorv1.OutputMode_OUTPUT_MODE_RAW_CHUNKS {
+		return nil
+	}
+	if req.StopOnPattern != "" {
+		return invalidField("stop_on_pattern", "stop_on_pattern cannot be used with OUTPUT_MODE_RAW_CHUNKS")
+	}
+	filter, err := s.outputFilter(req)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		return invalidField("output_filters", "output filters cannot be used with OUTPUT_MODE_RAW_CHUNKS, set output_filters to [\"%s\"]", noOutputFilters)
+	}
+	if s.config.MaxOutputLinesPerSec > 0 {
+		return fieldError(codes.FailedPrecondition, reasonNotConfigured, "output_mode", "OUTPUT_MODE_RAW_CHUNKS cannot be used while executor.max_output_lines_per_sec is set")
+	}
+	return nil
+}
+
+// fastPath reports whether req's output can be handed straight to the
+// command, which requires that no one watches it live and it is not
+// transformed by secret masking or output filters
+func (s *ExecutorServer) fastPath(req *executorv1.ExecuteRequest, watched, transformed bool) bool {
+	return req.FastPath && !watched && !transformed && s.forwarder == nil && req.StopOnPattern == "" &&
+		req.IdleTimeoutSeconds <= 0 && s.config.MaxOutputLinesPerSec <= 0
+}
+
+// maxLifetime returns how long a process may run in total: the shorter of
+// executor.max_lifetime and the request's max_lifetime_seconds, 0 if neither
+// is set
+func (s *ExecutorServer) maxLifetime(req *executorv1.ExecuteRequest) time.Duration {
+	seconds := s.config.MaxLifetime
+	if req.MaxLifetimeSeconds > 0 && (seconds <= 0 || int(req.MaxLifetimeSeconds) < seconds) {
+		seconds = int(req.MaxLifetimeSeconds)
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// forwardLabels identifies an execution's lines for the log aggregator
+func forwardLabels(processID, tool string, scope *pipelineScope) map[string]string {
+	labels := map[string]string{"process_id": processID, "tool": tool}
+	if scope != nil {
+		labels["pipeline_id"] = scope.pipelineID
+		labels["step_name"] = scope.stepName
+	}
+	return labels
+}
+
+// startHeartbeat emits periodic events to obs while proc runs until the
+// returned stop function is called
+func (s *ExecutorServer) startHeartbeat(ctx context.Context, proc *RunningProcess, obs *observer) func() {
+	deadline, hasDeadline := ctx.Deadline()
+	if obs.deadline == nil || !hasDeadline {
+		return func() {}
+	}
+
+	ticker := time.NewTicker(time.Duration(s.config.HeartbeatInterval) * time.Second)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stdoutBytes, stderrBytes := proc.OutputBytes()
+				obs.deadline(&executorv1.DeadlineEvent{
+					RemainingMs: time.Until(deadline).Milliseconds(),
+					Deadline:    timestamppb.New(deadline),
+					BytesSoFar:  stdoutBytes + stderrBytes,
+				})
+			}
+		}
+	}()
+
+	return func() {
+		ticker.Stop()
+		close(done)
+		<-stopped
+	}
+}
+
+// stepRequest builds the execute request for a pipeline step, resolving its
+// working directory against the pipeline workspace
+func stepRequest(pipelineReq *executorv1.PipelineRequest, step *executorv1.BuildStep) *executorv1.ExecuteRequest {
+	execReq := &executorv1.ExecuteRequest{
+		Tool:               step.Tool,
+		Args:               step.Args,
+		TimeoutSeconds:     step.TimeoutSeconds,
+		ProgressPattern:    step.ProgressPattern,
+		LogArgs:            step.LogArgs,
+		ShellCommand:       step.ShellCommand,
+		ShellPath:          step.ShellPath,
+		Priority:           step.Priority,
+		IoPriority:         step.IoPriority,
+		TailLines:          step.TailLines,
+		ExpectedExitCodes:  step.ExpectedExitCodes,
+		SuccessFile:        step.SuccessFile,
+		CaptureStdout:      step.CaptureStdout,
+		CaptureStderr:      step.CaptureStderr,
+		IsolateHome:        step.IsolateHome,
+		EmitEnvSnapshot:    step.EmitEnvSnapshot,
+		OutputMode:         step.OutputMode,
+		HashOutput:         step.HashOutput,
+		HashStderr:         step.HashStderr,
+		StopOnPattern:      step.StopOnPattern,
+		MaxLifetimeSeconds: step.MaxLifetimeSeconds,
+		IdleTimeoutSeconds: step.IdleTimeoutSeconds,
+	}
+
+	// Step env overrides pipeline env, which overrides the inherited env
+	execReq.Env = mergeEnv(pipelineReq.Env, step.Env)
+
+	// Set working directory
+	if step.WorkDir != "" && pipelineReq.WorkspaceDir != "" {
+		execReq.WorkDir = filepath.Join(pipelineReq.WorkspaceDir, step.WorkDir)
+	} else if step.WorkDir != "" {
+		execReq.WorkDir = step.WorkDir
+	} else if pipelineReq.WorkspaceDir != "" {
+		execReq.WorkDir = pipelineReq.WorkspaceDir
+	}
+
+	return execReq
+}
+
+// withDefaultArgs adds executor.tool_default_args for tool to args
+func (s *ExecutorServer) withDefaultArgs(tool string, args []string) []string {
+	defaults, ok := s.config.ToolDefaultArgs[strings.ToLower(tool)]
+	if !ok || len(defaults.Args) == 0 {
+		return args
+	}
+
+	combined := make([]string, 0, len(defaults.Args)+len(args))
+	if defaults.Position == "append" {
+		combined = append(combined, args...)
+		return append(combined, defaults.Args...)
+	}
+	combined = append(combined, defaults.Args...)
+	return append(combined, args...)
+}
+
+// command builds a command bound to ctx in its own process group. When ctx is
+// done the group receives the process's cancel signal and is killed if it is
+// still running after the grace period.
+func (s *ExecutorServer) command(ctx context.Context, proc *RunningProcess, tool string, args []string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, tool, args...)
+	setProcessGroup(cmd)
+	proc.Command = cmd
+
+	grace := time.Duration(s.config.CancelGracePeriod) * time.Second
+	cmd.Cancel = func() error {
+		// Without a grace period kill immediately
+		if grace <= 0 {
+			return signalProcessGroup(cmd.Process, syscall.SIGKILL)
+		}
+
+		sig := syscall.Signal(proc.signal.Load())
+		if sig == 0 {
+			sig = s.cancelSignal
+		}
+		err := signalProcessGroup(cmd.Process, sig)
+
+		// WaitDelay only kills the direct child, so escalate for the whole
+		// group in case a child holds the output pipes open
+		time.AfterFunc(grace, func() {
+			if !proc.exited.Load() {
+				signalProcessGroup(cmd.Process, syscall.SIGKILL)
+			}
+		})
+		return err
+	}
+	cmd.WaitDelay = grace
+
+	return cmd
+}
+
+// closeFiles closes each file, ignoring errors as there is nothing left to
+// do with them
+func closeFiles(files ...*os.File) {
+	for _, f := range files {
+		f.Close()
+	}
+}
+
+// newCapture creates the capture for one output stream, recording an
+// OUTPUT_BYTES limit event when it is cut at executor.max_output_bytes. The
+// stream is only counted when keep is set to false.
+func (s *ExecutorServer) newCapture(processID, stream string, tailLines int32, keep *bool, limits *limitRecorder) *capture {
+	if keep != nil && !*keep {
+		return &capture{discard: true}
+	}
+
+	limit := s.config.MaxOutputBytes
+	c := &capture{
+		limit: limit,
+		spill: s.newSpillFile(processID, stream),
+		onLimit: func() {
+			limits.record(executorv1.LimitKind_LIMIT_KIND_OUTPUT_BYTES, limit, stream)
+		},
+	}
+
+	// The tail bounds memory itself, the limit then only caps the spill file
+	if tailLines > 0 {
+		c.tail = newLineRing(int(tailLines))
+		if c.spill == nil {
+			c.limit = 0
+		}
+	}
+	return c
+}
+
+// readChunks captures r as it is read, passing each chunk to the observer.
+// Secrets are masked across chunk boundaries, so a chunk may be delivered
+// only once the next one was read.
+func (s *ExecutorServer) readChunks(r io.Reader, out *capture, isStdout bool, _ *limitRecorder, obs *observer) {
+	masker := out.mask.chunks()
+	deliver := func(data []byte) {
+		if len(data) == 0 {
+			return
+		}
+		out.write(string(data), false)
+		if obs.chunk != nil {
+			obs.chunk(bytes.Clone(data), isStdout)
+		}
+	}
+	defer func() { deliver(masker.flush()) }()
+
+	buf := make([]byte, s.config.ScanBufferBytes)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			deliver(masker.write(buf[:n]))
+		}
+		if err != nil {
+			if err != io.EOF {
+				s.logger.Warn("stopped reading output", zap.Bool("stdout", isStdout), zap.Error(err))
+			}
+			return
+		}
+	}
+}
+
+// readOutput captures r line by line into out, passing each line to the
+// observer. Overlong lines and output beyond the capture limit are recorded
+// as limit events.
+func (s *ExecutorServer) readOutput(r io.Reader, out *capture, isStdout bool, limits *limitRecorder, obs *observer) {
+	stream := "stderr"
+	if isStdout {
+		stream = "stdout"
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, s.config.ScanBufferBytes), s.config.MaxLineBytes)
+	scanner.Split(truncatingLines(s.config.MaxLineBytes, func() {
+		limits.record(executorv1.LimitKind_LIMIT_KIND_LINE_BYTES, int64(s.config.MaxLineBytes), stream)
+	}))
+
+	deliver := func(line string) {
+		out.writeLine(line)
+
+		if obs.line != nil {
+			obs.line(line, isStdout)
+		}
+	}
+	send := deliver
+	limiter := s.newLineLimiter(isStdout, limits, obs, deliver)
+	if limiter != nil {
+		send = limiter.line
+	}
+
+	for scanner.Scan() {
+		send(out.filter.apply(scanner.Text()))
+	}
+	limiter.close()
+
+	if err := scanner.Err(); err != nil {
+		s.logger.Warn("stopped reading output", zap.Bool("stdout", isStdout), zap.Error(err))
+
+		// Keep draining so the process does not block on a full pipe
+		io.Copy(io.Discard, r)
+	}
+}
@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// openFDs returns the number of file descriptors open in this process
+func openFDs(t *testing.T) int {
+	t.Helper()
+	entries, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		t.Fatalf("failed to list open files: %v", err)
+	}
+	return len(entries)
+}
+
+func TestPipeFailureLeaksNoFiles(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("counts open files in /proc")
+	}
+
+	tests := []struct {
+		name    string
+		failOn  int // the pipe creation that fails, counting from 1
+		wantErr string
+	}{
+		{name: "stdout pipe", failOn: 1, wantErr: "failed to create stdout pipe"},
+		{name: "stderr pipe", failOn: 2, wantErr: "failed to create stderr pipe"},
+	}
+
+	s := newTestServer(t, nil)
+	req := &executorv1.ExecuteRequest{Tool: "echo", Args: []string{"hello"}}
+
+	// Warm up anything opened once per process
+	if _, err := s.Execute(context.Background(), req); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			osPipe = func() (*os.File, *os.File, error) {
+				calls++
+				if calls == tt.failOn {
+					return nil, nil, errors.New("too many open files")
+				}
+				return os.Pipe()
+			}
+			defer func() { osPipe = os.Pipe }()
+
+			before := openFDs(t)
+			_, err := s.Execute(context.Background(), req)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Execute error = %v, want %q", err, tt.wantErr)
+			}
+			if after := openFDs(t); after != before {
+				t.Errorf("%d files open after the failed execution, %d before", after, before)
+			}
+		})
+	}
+}
+
+// BenchmarkExecute compares reading a command's output through the fast
+// path with scanning it line by line
+func BenchmarkExecute(b *testing.B) {
+	s := newTestServer(b, nil)
+
+	for _, bench := range []struct {
+		name     string
+		fastPath bool
+	}{
+		{name: "scanned", fastPath: false},
+		{name: "fast_path", fastPath: true},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			req := &executorv1.ExecuteRequest{
+				Tool:     "sh",
+				Args:     []string{"-c", "yes 'a line of build output' | head -n 100000"},
+				FastPath: bench.fastPath,
+			}
+			for i := 0; i < b.N; i++ {
+				response, err := s.Execute(context.Background(), req)
+				if err != nil || !response.Success {
+					b.Fatalf("Execute: %v %v", err, response.GetError())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/knullci/necrosword/internal/config"
+	"google.golang.org/grpc/codes"
+)
+
+// secretMask replaces secret values in output, logs and echoed arguments
+const secretMask = "***"
+
+// secretPattern matches ${secret:<ref>} references in args and env
+var secretPattern = regexp.MustCompile(`\$\{secret:([^}]+)\}`)
+
+// referencePattern matches ${secret:<ref>} references and ${NAME} template
+// references in a single pass
+var referencePattern = regexp.MustCompile(`\$\{(?:secret:([^}]+)|([A-Za-z_][A-Za-z0-9_]*))\}`)
+
+// SecretProvider fetches the values of ${secret:<ref>} references when a
+// command runs. Errors must not include the secret value.
+type SecretProvider interface {
+	Secret(ctx context.Context, ref string) (string, error)
+}
+
+// envSecrets reads secrets from the server's environment, ref myapp/token
+// naming the variable <prefix>MYAPP_TOKEN
+type envSecrets struct {
+	prefix string
+}
+
+// envSecretName maps characters other than letters and digits to _
+var envSecretName = regexp.MustCompile(`[^A-Za-z0-9]`)
+
+// Secret implements SecretProvider
+func (p envSecrets) Secret(ctx context.Context, ref string) (string, error) {
+	name := p.prefix + strings.ToUpper(envSecretName.ReplaceAllString(ref, "_"))
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return value, nil
+}
+
+// fileSecrets reads secrets from files under dir, ref myapp/token naming
+// the file <dir>/myapp/token. A trailing newline is dropped.
+type fileSecrets struct {
+	dir string
+}
+
+// Secret implements SecretProvider
+func (p fileSecrets) Secret(ctx context.Context, ref string) (string, error) {
+	if !filepath.IsLocal(ref) {
+		return "", fmt.Errorf("reference must be a relative path inside the secrets dir")
+	}
+	data, err := os.ReadFile(filepath.Join(p.dir, ref))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r"), nil
+}
+
+// newSecretProvider returns the provider selected by executor.secrets, nil
+// if none is
+func newSecretProvider(cfg config.SecretsConfig) SecretProvider {
+	switch cfg.Provider {
+	case "env":
+		return envSecrets{prefix: cfg.EnvPrefix}
+	case "file":
+		return fileSecrets{dir: cfg.Dir}
+	}
+	return nil
+}
+
+// SetSecretProvider replaces the provider selected by executor.secrets, so
+// other secret stores can be compiled in
+func (s *ExecutorServer) SetSecretProvider(p SecretProvider) {
+	s.secrets = p
+}
+
+// resolveSecrets expands the ${NAME} template variables of vars and fetches
+// the ${secret:<ref>} references in values, appending the fetched secrets
+// for masking. Both are replaced in one pass over the request's own values,
+// so a variable's value, such as a previous step's captured output, is
+// never searched for secret references.
+func (s *ExecutorServer) resolveSecrets(ctx context.Context, field string, values []string, vars map[string]string, secrets *[]string) ([]string, error) {
+	if len(values) == 0 {
+		return values, nil
+	}
+
+	resolved := make([]string, len(values))
+	for i, value := range values {
+		var err error
+		resolved[i] = referencePattern.ReplaceAllStringFunc(value, func(ref string) string {
+			match := referencePattern.FindStringSubmatch(ref)
+			if match[1] == "" {
+				if v, ok := vars[match[2]]; ok {
+					return v
+				}
+				return ref
+			}
+			if err != nil {
+				return ref
+			}
+
+			name := match[1]
+			if s.secrets == nil {
+				err = fieldError(codes.FailedPrecondition, reasonNotConfigured, field, "secret %s is referenced but no secrets provider is configured", name)
+				return ref
+			}
+			secret, fetchErr := s.secrets.Secret(ctx, name)
+			if fetchErr != nil {
+				err = fieldError(codes.FailedPrecondition, reasonSecret, field, "failed to fetch secret %s: %v", name, fetchErr)
+				return ref
+			}
+			if secret != "" {
+				*secrets = append(*secrets, secret)
+			}
+			return secret
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
+// masker hides secret values. A nil masker leaves text unchanged.
+type masker struct {
+	r       *strings.Replacer
+	secrets []string
+	maxLen  int // length of the longest secret
+}
+
+// newMasker returns a masker for secrets, nil if there are none
+func newMasker(secrets []string) *masker {
+	if len(secrets) == 0 {
+		return nil
+	}
+	m := &masker{secrets: secrets}
+	pairs := make([]string, 0, 2*len(secrets))
+	for _, secret := range secrets {
+		pairs = append(pairs, secret, secretMask)
+		m.maxLen = max(m.maxLen, len(secret))
+	}
+	m.r = strings.NewReplacer(pairs...)
+	return m
+}
+
+// mask returns text with the secrets replaced
+func (m *masker) mask(text string) string {
+	if m == nil {
+		return text
+	}
+	return m.r.Replace(text)
+}
+
+// maskAll returns values with the secrets replaced
+func (m *masker) maskAll(values []string) []string {
+	if m == nil {
+		return values
+	}
+	masked := make([]string, len(values))
+	for i, value := range values {
+		masked[i] = m.r.Replace(value)
+	}
+	return masked
+}
+
+// withMask returns obs with secrets masked in the output it receives
+func withMask(obs *observer, m *masker) *observer {
+	if m == nil {
+		return obs
+	}
+
+	wrapped := *obs
+	if obs.line != nil {
+		wrapped.line = func(line string, isStdout bool) {
+			obs.line(m.mask(line), isStdout)
+		}
+	}
+	return &wrapped
+}
+
+// chunkMasker masks secrets in output read in arbitrary chunks. The end of
+// each chunk, where a secret may continue in the next one, is held back
+// until more output arrives. Chunks are masked by readChunks itself, before
+// they reach the capture and the observer.
+type chunkMasker struct {
+	m       *masker
+	pending []byte
+}
+
+// write returns the masked output that is safe to deliver after data was
+// read. A nil chunkMasker returns data as is.
+func (c *chunkMasker) write(data []byte) []byte {
+	if c == nil {
+		return data
+	}
+	c.pending = append(c.pending, data...)
+
+	// Hold back whatever a secret could still be completing, and move the
+	// cut before any secret it would split
+	cut := len(c.pending) - (c.m.maxLen - 1)
+	if cut <= 0 {
+		return nil
+	}
+	for start := max(0, cut-c.m.maxLen+1); start < cut; start++ {
+		if c.secretAt(start, cut) {
+			cut = start
+			break
+		}
+	}
+
+	out := []byte(c.m.mask(string(c.pending[:cut])))
+	c.pending = append(c.pending[:0], c.pending[cut:]...)
+	return out
+}
+
+// secretAt reports whether a secret starts at start of the pending output
+// and extends past cut
+func (c *chunkMasker) secretAt(start, cut int) bool {
+	for _, secret := range c.m.secrets {
+		if start+len(secret) > cut && bytes.HasPrefix(c.pending[start:], []byte(secret)) {
+			return true
+		}
+	}
+	return false
+}
+
+// flush returns the masked output held back, once no more follows
+func (c *chunkMasker) flush() []byte {
+	if c == nil || len(c.pending) == 0 {
+		return nil
+	}
+	out := []byte(c.m.mask(string(c.pending)))
+	c.pending = nil
+	return out
+}
+
+// chunks returns a chunkMasker for m, nil if there are no secrets
+func (m *masker) chunks() *chunkMasker {
+	if m == nil {
+		return nil
+	}
+	return &chunkMasker{m: m}
+}
@@ -1,24 +1,34 @@
 package grpc
 
 import (
-	"bufio"
 	"context"
 	"fmt"
-	"io"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
+	"unicode/utf8"
 
-	"github.com/google/uuid"
 	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
 	"github.com/knullci/necrosword/internal/config"
+	"github.com/knullci/necrosword/internal/signals"
 	"go.uber.org/zap"
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var startTime = time.Now()
 
+// MaxMessageBytes is the largest gRPC message the server sends or receives
+const MaxMessageBytes = 50 * 1024 * 1024
+
 // ExecutorServer implements the gRPC ExecutorService
 type ExecutorServer struct {
 	executorv1.UnimplementedExecutorServiceServer
@@ -27,8 +37,76 @@ type ExecutorServer struct {
 	running   map[string]*RunningProcess
 	pipelines map[string]*RunningPipeline
 	mu        sync.RWMutex
+
+	// cancelSignal is delivered to cancelled processes unless overridden
+	cancelSignal syscall.Signal
+
+	// slots bounds the concurrently running processes, each taking its
+	// tool's weight out of maxConcurrent
+	slots         *semaphore.Weighted
+	maxConcurrent int64
+
+	// streams bounds the number of open streaming calls, nil if unlimited
+	streams chan struct{}
+
+	// throttleReason is why admission control last refused executions ("" if not)
+	throttleReason atomic.Value
+
+	// hostname is exposed to executions as ${NECRO_HOST}
+	hostname string
+
+	// versionChecks caches tool version verification per tool
+	versionChecks sync.Map
+
+	// toolChecks holds the latest health probe result per tool
+	toolChecks sync.Map
+
+	// tools matches requested tools against the allowlist, swapped on reload
+	tools atomic.Pointer[config.ToolMatcher]
+
+	// validators check every command, the allowlist first
+	validators []ToolValidator
+
+	// secrets resolves ${secret:<ref>} references, nil if none is configured
+	secrets SecretProvider
+
+	// connections counts open client connections, see LimitListener
+	connections    atomic.Int64
+	maxConnections int
+
+	// results holds the results of detached executions by process ID
+	results   map[string]*detachedResult
+	resultsMu sync.Mutex
+
+	// replays holds the recorded events of pipeline streams by pipeline ID
+	replays   map[string]*pipelineLog
+	replaysMu sync.Mutex
+
+	// fences holds the highest fence token seen per job key
+	fences   map[string]*fence
+	fencesMu sync.Mutex
+
+	// workspaces are the named workspaces currently acquired
+	workspaces   map[string]*namedWorkspace
+	workspacesMu sync.Mutex
+
+	// forwarder ships output lines to executor.log_forward, nil if disabled
+	forwarder *logForwarder
+
+	// logs feeds StreamLogs, nil if no broadcaster was set
+	logs *LogBroadcaster
+
+	// ids mints process and pipeline IDs
+	ids *idGenerator
+
+	// done is closed when the server is closed, stopping background work
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
+// maxCancelWait caps how long CancelProcess waits for a process to exit
+const maxCancelWait = 30 * time.Second
+
 // RunningProcess tracks a running process
 type RunningProcess struct {
 	ID         string
@@ -38,273 +116,430 @@ type RunningProcess struct {
 	Cancel     context.CancelFunc
 	StartedAt  time.Time
 	PipelineID string // Optional: which pipeline this process belongs to
+	Tenant     string // tenant that started the process, "" without tenant isolation
+
+	signal   atomic.Int32  // signal delivered on cancellation, 0 = server default
+	exited   atomic.Bool   // set once the process has been waited for
+	exitedAt atomic.Int64  // when exited was set, in Unix nanoseconds
+	waited   chan struct{} // closed once the process has been waited for
+	logArgs  bool          // whether Args may appear in logs
+
+	// goneSince is when the sweeper first found the process gone before it
+	// was waited for, guarded by ExecutorServer.mu
+	goneSince time.Time
+
+	stdout, stderr *capture // output captured so far
+
+	hub *outputHub // fans output out to TailProcess, nil on the fast path
+}
+
+// markExited records that the process has been waited for. Its entry stays
+// tracked until the runner finished, e.g. ran the post-exec hook.
+func (p *RunningProcess) markExited() {
+	p.exitedAt.Store(time.Now().UnixNano())
+	p.exited.Store(true)
+	close(p.waited)
+}
+
+// Terminate cancels the process, delivering sig before the grace-period kill
+func (p *RunningProcess) Terminate(sig syscall.Signal) {
+	p.signal.Store(int32(sig))
+	p.Cancel()
+}
+
+// awaitExit waits up to timeout for the process to exit and reports whether
+// it did
+func (p *RunningProcess) awaitExit(ctx context.Context, timeout time.Duration) bool {
+	if timeout <= 0 {
+		return p.exited.Load()
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-p.waited:
+		return true
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+	return p.exited.Load()
+}
+
+// matchesTool reports whether the process runs tool, by name or file name
+func (p *RunningProcess) matchesTool(tool string) bool {
+	return strings.EqualFold(p.Tool, tool) || strings.EqualFold(filepath.Base(p.Tool), tool)
+}
+
+// OutputBytes returns the stdout and stderr bytes read so far
+func (p *RunningProcess) OutputBytes() (stdout, stderr int64) {
+	if p.stdout != nil {
+		stdout = p.stdout.written.Load()
+	}
+	if p.stderr != nil {
+		stderr = p.stderr.written.Load()
+	}
+	return stdout, stderr
 }
 
 // RunningPipeline tracks a running pipeline for cancellation
 type RunningPipeline struct {
 	ID        string
 	Name      string
+	Tenant    string
 	Cancel    context.CancelFunc
 	StartedAt time.Time
 }
 
-// NewExecutorServer creates a new gRPC executor server
-func NewExecutorServer(cfg *config.ExecutorConfig, logger *zap.Logger) *ExecutorServer {
-	return &ExecutorServer{
-		config:    cfg,
-		logger:    logger,
-		running:   make(map[string]*RunningProcess),
-		pipelines: make(map[string]*RunningPipeline),
+// NewExecutorServer creates a new gRPC executor server. Every command is
+// checked against the allowed tools first, then against the given
+// validators in order, so custom policies can be compiled in:
+//
+//	grpcserver.NewExecutorServer(cfg, logger, grpcserver.ToolValidatorFunc(
+//		func(ctx context.Context, tool string, args, env []string) error {
+//			if tool == "docker" && !approvedRegistry(args) {
+//				return errors.New("images must come from an approved registry")
+//			}
+//			return nil
+//		}))
+func NewExecutorServer(cfg *config.ExecutorConfig, logger *zap.Logger, validators ...ToolValidator) *ExecutorServer {
+	cancelSignal, err := signals.Parse(cfg.DefaultCancelSignal)
+	if err != nil {
+		cancelSignal = syscall.SIGTERM
 	}
-}
 
-// Execute runs a single command and returns the result
-func (s *ExecutorServer) Execute(ctx context.Context, req *executorv1.ExecuteRequest) (*executorv1.ExecuteResponse, error) {
-	// Validate tool
-	if !s.config.IsToolAllowed(req.Tool) {
-		return nil, fmt.Errorf("tool '%s' is not allowed. Allowed tools: %v", req.Tool, s.config.AllowedTools)
+	hostname, err := os.Hostname()
+	if err != nil {
+		logger.Warn("failed to resolve hostname", zap.Error(err))
 	}
 
-	// Create timeout context
-	timeout := time.Duration(s.config.DefaultTimeout) * time.Second
-	if req.TimeoutSeconds > 0 {
-		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	tools, err := config.NewToolMatcher(cfg.AllowedTools)
+	if err != nil {
+		logger.Error("invalid allowed_tools, no tools are allowed", zap.Error(err))
+		tools = &config.ToolMatcher{}
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
+	maxConcurrent := cfg.MaxConcurrent
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+
+	s := &ExecutorServer{
+		config:        cfg,
+		logger:        logger,
+		running:       make(map[string]*RunningProcess),
+		pipelines:     make(map[string]*RunningPipeline),
+		workspaces:    make(map[string]*namedWorkspace),
+		results:       make(map[string]*detachedResult),
+		replays:       make(map[string]*pipelineLog),
+		fences:        make(map[string]*fence),
+		ids:           newIDGenerator(cfg.IDScheme),
+		cancelSignal:  cancelSignal,
+		slots:         semaphore.NewWeighted(int64(maxConcurrent)),
+		maxConcurrent: int64(maxConcurrent),
+		hostname:      hostname,
+		done:          make(chan struct{}),
+	}
+	s.throttleReason.Store("")
+	s.tools.Store(tools)
+	s.validators = append([]ToolValidator{allowlistValidator{s}}, validators...)
 
-	// Build command
-	cmd := exec.CommandContext(ctx, req.Tool, req.Args...)
+	if cfg.MaxConcurrentStreams > 0 {
+		s.streams = make(chan struct{}, cfg.MaxConcurrentStreams)
+	}
 
-	if req.WorkDir != "" {
-		cmd.Dir = req.WorkDir
+	if cfg.SweepInterval > 0 {
+		go s.sweep(time.Duration(cfg.SweepInterval) * time.Second)
+	}
+	if cfg.SpillToDisk.Enabled {
+		go s.expireSpillFiles()
+	}
+	s.secrets = newSecretProvider(cfg.Secrets)
+	if cfg.HealthcheckOnStartup {
+		go s.checkAllTools()
 	}
+	if cfg.LogForward.Type != "" {
+		s.forwarder = newLogForwarder(cfg.LogForward, logger, s.done)
+	}
+
+	return s
+}
 
-	if len(req.Env) > 0 {
-		cmd.Env = append(cmd.Environ(), req.Env...)
+// Close stops the server's background work
+func (s *ExecutorServer) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+}
+
+// Execute runs a single command and returns the result
+func (s *ExecutorServer) Execute(ctx context.Context, req *executorv1.ExecuteRequest) (*executorv1.ExecuteResponse, error) {
+	req, err := s.resolveShell(req)
+	if err != nil {
+		return nil, err
 	}
 
-	// Capture output
-	stdout, err := cmd.StdoutPipe()
+	req, releaseWorkspace, err := s.resolveWorkspace(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create stdout pipe: %w", err)
+		return nil, err
 	}
+	defer releaseWorkspace()
 
-	stderr, err := cmd.StderrPipe()
+	// Validate tool
+	if err := s.validateTool(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenantDir(ctx, "work_dir", req.WorkDir); err != nil {
+		return nil, err
+	}
+	if err := s.checkFence(ctx, req.JobKey, req.FenceToken); err != nil {
+		return nil, err
+	}
+
+	// Create working directory if requested, removing it afterwards per policy
+	createdWorkDir, err := s.prepareWorkDir(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create stderr pipe: %w", err)
+		return nil, err
 	}
 
-	// Start command
-	startTime := time.Now()
-	processID := uuid.New().String()
+	succeeded := false
+	if createdWorkDir {
+		defer func() {
+			s.cleanupWorkDir(req.WorkDir, req.CleanupWorkDir, succeeded)
+		}()
+	}
 
-	if err := cmd.Start(); err != nil {
-		return nil, fmt.Errorf("failed to start command: %w", err)
+	response, err := s.run(ctx, req, nil, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	// Track running process
-	runningProc := &RunningProcess{
-		ID:        processID,
-		Tool:      req.Tool,
-		Args:      req.Args,
-		Command:   cmd,
-		Cancel:    cancel,
-		StartedAt: startTime,
+	succeeded = response.Success
+	return response, nil
+}
+
+// ExecuteBatch runs many independent commands concurrently, up to
+// MaxConcurrent at a time, returning their results in request order
+func (s *ExecutorServer) ExecuteBatch(ctx context.Context, req *executorv1.ExecuteBatchRequest) (*executorv1.ExecuteBatchResponse, error) {
+	if maxSize := s.config.MaxBatchSize; maxSize > 0 && len(req.Requests) > maxSize {
+		return nil, invalidField("requests", "batch of %d requests exceeds executor.max_batch_size of %d", len(req.Requests), maxSize)
 	}
+	startTime := time.Now()
 
-	s.mu.Lock()
-	s.running[processID] = runningProc
-	s.mu.Unlock()
+	results := make([]*executorv1.BatchResult, len(req.Requests))
 
-	defer func() {
-		s.mu.Lock()
-		delete(s.running, processID)
-		s.mu.Unlock()
-	}()
+	// At most MaxConcurrent requests of the batch are in flight, rather than
+	// a goroutine per request queueing for a slot
+	inFlight := make(chan struct{}, max(s.config.MaxConcurrent, 1))
 
-	// Read output
 	var wg sync.WaitGroup
-	var stdoutBuf, stderrBuf strings.Builder
+	for i, execReq := range req.Requests {
+		results[i] = &executorv1.BatchResult{Index: int32(i)}
 
-	wg.Add(2)
-	go func() {
-		defer wg.Done()
-		s.readOutput(stdout, &stdoutBuf)
-	}()
-	go func() {
-		defer wg.Done()
-		s.readOutput(stderr, &stderrBuf)
-	}()
-
-	wg.Wait()
+		// Once the call ends, the commands still waiting are never started
+		select {
+		case inFlight <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			for j := i; j < len(results); j++ {
+				results[j] = &executorv1.BatchResult{Index: int32(j), Error: batchCancelledError(ctx)}
+			}
+			break
+		}
 
-	// Wait for command
-	err = cmd.Wait()
-	endTime := time.Now()
-	duration := endTime.Sub(startTime)
+		wg.Add(1)
+		go func(result *executorv1.BatchResult, execReq *executorv1.ExecuteRequest) {
+			defer wg.Done()
+			defer func() { <-inFlight }()
 
-	response := &executorv1.ExecuteResponse{
-		ProcessId:  processID,
-		Tool:       req.Tool,
-		Args:       req.Args,
-		Stdout:     stdoutBuf.String(),
-		Stderr:     stderrBuf.String(),
-		DurationMs: duration.Milliseconds(),
-		StartedAt:  timestamppb.New(startTime),
-		EndedAt:    timestamppb.New(endTime),
+			execResult, err := s.Execute(ctx, execReq)
+			if err != nil {
+				result.Error = err.Error()
+				return
+			}
+			result.Result = execResult
+		}(results[i], execReq)
 	}
 
-	if err != nil {
-		if ctx.Err() == context.DeadlineExceeded {
-			response.ExitCode = -1
-			response.Error = "command timed out"
-			response.TimedOut = true
-		} else if exitErr, ok := err.(*exec.ExitError); ok {
-			response.ExitCode = int32(exitErr.ExitCode())
-			response.Error = exitErr.Error()
+	wg.Wait()
+
+	response := &executorv1.ExecuteBatchResponse{
+		Results:    results,
+		DurationMs: time.Since(startTime).Milliseconds(),
+	}
+	for _, result := range results {
+		if result.Result != nil && result.Result.Success {
+			response.Succeeded++
 		} else {
-			response.ExitCode = -1
-			response.Error = err.Error()
+			response.Failed++
 		}
-		response.Success = false
-	} else {
-		response.ExitCode = 0
-		response.Success = true
 	}
 
-	s.logger.Info("command executed",
-		zap.String("process_id", processID),
-		zap.String("tool", req.Tool),
-		zap.Strings("args", req.Args),
-		zap.Int32("exit_code", response.ExitCode),
-		zap.Duration("duration", duration),
-		zap.Bool("success", response.Success),
+	s.logger.Info("batch executed",
+		zap.Int("commands", len(results)),
+		zap.Int32("succeeded", response.Succeeded),
+		zap.Int32("failed", response.Failed),
+		zap.Int64("duration_ms", response.DurationMs),
 	)
 
 	return response, nil
 }
 
-// ExecuteStream runs a command and streams output in real-time
-func (s *ExecutorServer) ExecuteStream(req *executorv1.ExecuteRequest, stream executorv1.ExecutorService_ExecuteStreamServer) error {
-	// Validate tool
-	if !s.config.IsToolAllowed(req.Tool) {
-		return fmt.Errorf("tool '%s' is not allowed. Allowed tools: %v", req.Tool, s.config.AllowedTools)
+// batchCancelledError describes a batch command never started because the
+// batch call ended
+func batchCancelledError(ctx context.Context) string {
+	if ctx.Err() == context.DeadlineExceeded {
+		return "batch timed out before the command started"
 	}
+	return "batch cancelled before the command started"
+}
 
-	ctx := stream.Context()
-
-	// Create timeout context
-	timeout := time.Duration(s.config.DefaultTimeout) * time.Second
-	if req.TimeoutSeconds > 0 {
-		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+// ExecuteStream runs a command and streams output in real-time
+func (s *ExecutorServer) ExecuteStream(req *executorv1.ExecuteRequest, stream executorv1.ExecutorService_ExecuteStreamServer) error {
+	release, err := s.acquireStream()
+	if err != nil {
+		return err
 	}
+	defer release()
 
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
-
-	// Build command
-	cmd := exec.CommandContext(ctx, req.Tool, req.Args...)
-
-	if req.WorkDir != "" {
-		cmd.Dir = req.WorkDir
+	req, err = s.resolveShell(req)
+	if err != nil {
+		return err
 	}
 
-	if len(req.Env) > 0 {
-		cmd.Env = append(cmd.Environ(), req.Env...)
+	// Validate tool
+	if err := s.validateTool(stream.Context(), req); err != nil {
+		return err
 	}
 
-	// Create pipes
-	stdout, err := cmd.StdoutPipe()
+	req, releaseWorkspace, err := s.resolveWorkspace(stream.Context(), req)
 	if err != nil {
-		return fmt.Errorf("failed to create stdout pipe: %w", err)
+		return err
 	}
-
-	stderr, err := cmd.StderrPipe()
-	if err != nil {
-		return fmt.Errorf("failed to create stderr pipe: %w", err)
+	if err := s.checkTenantDir(stream.Context(), "work_dir", req.WorkDir); err != nil {
+		releaseWorkspace()
+		return err
 	}
-
-	// Start command
-	startTime := time.Now()
-	processID := uuid.New().String()
-
-	if err := cmd.Start(); err != nil {
-		return fmt.Errorf("failed to start command: %w", err)
+	if err := s.checkFence(stream.Context(), req.JobKey, req.FenceToken); err != nil {
+		releaseWorkspace()
+		return err
 	}
 
-	// Track running process
-	runningProc := &RunningProcess{
-		ID:        processID,
-		Tool:      req.Tool,
-		Args:      req.Args,
-		Command:   cmd,
-		Cancel:    cancel,
-		StartedAt: startTime,
+	// Create working directory if requested, removing it afterwards per policy
+	createdWorkDir, err := s.prepareWorkDir(req)
+	if err != nil {
+		releaseWorkspace()
+		return err
 	}
 
-	s.mu.Lock()
-	s.running[processID] = runningProc
-	s.mu.Unlock()
+	// finish releases what the execution holds once it is done, which is
+	// after this call returns if the stream detaches
+	finish := func(succeeded bool) {
+		if createdWorkDir {
+			s.cleanupWorkDir(req.WorkDir, req.CleanupWorkDir, succeeded)
+		}
+		releaseWorkspace()
+	}
 
+	succeeded, detached := false, false
 	defer func() {
-		s.mu.Lock()
-		delete(s.running, processID)
-		s.mu.Unlock()
-	}()
-
-	// Stream output
-	var wg sync.WaitGroup
-	var stdoutBuf, stderrBuf strings.Builder
-
-	wg.Add(2)
-	go func() {
-		defer wg.Done()
-		s.streamOutput(stdout, &stdoutBuf, stream, true)
-	}()
-	go func() {
-		defer wg.Done()
-		s.streamOutput(stderr, &stderrBuf, stream, false)
+		if !detached {
+			finish(succeeded)
+		}
 	}()
 
-	wg.Wait()
-
-	// Wait for command
-	err = cmd.Wait()
-	endTime := time.Now()
-	duration := endTime.Sub(startTime)
-
-	response := &executorv1.ExecuteResponse{
-		ProcessId:  processID,
-		Tool:       req.Tool,
-		Args:       req.Args,
-		Stdout:     stdoutBuf.String(),
-		Stderr:     stderrBuf.String(),
-		DurationMs: duration.Milliseconds(),
-		StartedAt:  timestamppb.New(startTime),
-		EndedAt:    timestamppb.New(endTime),
+	// stdout and stderr are read concurrently but a stream allows one sender
+	sender := &streamSender[*executorv1.ExecuteStreamResponse]{send: stream.Send, logger: s.logger}
+
+	var stdoutLines, stderrLines atomic.Int64
+
+	obs := &observer{
+		line: func(line string, isStdout bool) {
+			if isStdout {
+				stdoutLines.Add(1)
+				sender.Send(&executorv1.ExecuteStreamResponse{
+					Output: &executorv1.ExecuteStreamResponse_StdoutLine{
+						StdoutLine: line,
+					},
+				})
+			} else {
+				stderrLines.Add(1)
+				sender.Send(&executorv1.ExecuteStreamResponse{
+					Output: &executorv1.ExecuteStreamResponse_StderrLine{
+						StderrLine: line,
+					},
+				})
+			}
+		},
+		limit: func(event *executorv1.LimitEvent) {
+			sender.Send(&executorv1.ExecuteStreamResponse{
+				Output: &executorv1.ExecuteStreamResponse_Limit{Limit: event},
+			})
+		},
+		deadline: func(event *executorv1.DeadlineEvent) {
+			sender.Send(&executorv1.ExecuteStreamResponse{
+				Output: &executorv1.ExecuteStreamResponse_Deadline{Deadline: event},
+			})
+		},
+		progress: func(event *executorv1.ProgressEvent) {
+			sender.Send(&executorv1.ExecuteStreamResponse{
+				Output: &executorv1.ExecuteStreamResponse_Progress{Progress: event},
+			})
+		},
+		env: func(event *executorv1.EnvSnapshotEvent) {
+			sender.Send(&executorv1.ExecuteStreamResponse{
+				Output: &executorv1.ExecuteStreamResponse_EnvSnapshot{EnvSnapshot: event},
+			})
+		},
+		rateLimited: func(event *executorv1.RateLimitedEvent) {
+			sender.Send(&executorv1.ExecuteStreamResponse{
+				Output: &executorv1.ExecuteStreamResponse_RateLimited{RateLimited: event},
+			})
+		},
+		chunk: func(data []byte, isStdout bool) {
+			if isStdout {
+				sender.Send(&executorv1.ExecuteStreamResponse{
+					Output: &executorv1.ExecuteStreamResponse_StdoutChunk{StdoutChunk: data},
+				})
+			} else {
+				sender.Send(&executorv1.ExecuteStreamResponse{
+					Output: &executorv1.ExecuteStreamResponse_StderrChunk{StderrChunk: data},
+				})
+			}
+		},
 	}
 
-	if err != nil {
-		if ctx.Err() == context.DeadlineExceeded {
-			response.ExitCode = -1
-			response.Error = "command timed out"
-			response.TimedOut = true
-		} else if exitErr, ok := err.(*exec.ExitError); ok {
-			response.ExitCode = int32(exitErr.ExitCode())
-			response.Error = exitErr.Error()
-		} else {
-			response.ExitCode = -1
-			response.Error = err.Error()
+	var response *executorv1.ExecuteResponse
+	if req.DetachAfterLines > 0 {
+		response, detached, err = s.runDetachable(stream.Context(), req, obs, sender, finish)
+		if detached {
+			return nil
 		}
-		response.Success = false
 	} else {
-		response.ExitCode = 0
-		response.Success = true
+		response, err = s.run(stream.Context(), req, nil, obs)
+	}
+	if err != nil {
+		return err
+	}
+
+	succeeded = response.Success
+
+	// Send the summary footer, then the final result
+	if err := stream.Send(&executorv1.ExecuteStreamResponse{
+		Output: &executorv1.ExecuteStreamResponse_Summary{
+			Summary: &executorv1.SummaryEvent{
+				StdoutLines: stdoutLines.Load(),
+				StderrLines: stderrLines.Load(),
+				StdoutBytes: response.StdoutBytes,
+				StderrBytes: response.StderrBytes,
+				ExitCode:    response.ExitCode,
+				DurationMs:  response.DurationMs,
+			},
+		},
+	}); err != nil {
+		return err
 	}
 
-	// Send final result
 	return stream.Send(&executorv1.ExecuteStreamResponse{
 		Output: &executorv1.ExecuteStreamResponse_Result{
 			Result: response,
@@ -314,11 +549,33 @@ func (s *ExecutorServer) ExecuteStream(req *executorv1.ExecuteRequest, stream ex
 
 // ExecutePipeline runs a multi-step pipeline
 func (s *ExecutorServer) ExecutePipeline(ctx context.Context, req *executorv1.PipelineRequest) (*executorv1.PipelineResponse, error) {
+	if err := s.checkPipelineSteps(req); err != nil {
+		return nil, err
+	}
+	req, err := s.resolveSteps(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTenantDir(ctx, "workspace_dir", req.WorkspaceDir); err != nil {
+		return nil, err
+	}
+	inputs, err := planArtifacts(req.Steps)
+	if err != nil {
+		return nil, err
+	}
+	unchanged, err := planChanged(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkFence(ctx, req.JobKey, req.FenceToken); err != nil {
+		return nil, err
+	}
+
 	startTime := time.Now()
 
 	pipelineID := req.Id
 	if pipelineID == "" {
-		pipelineID = uuid.New().String()
+		pipelineID = s.ids.newID()
 	}
 
 	s.logger.Info("starting pipeline",
@@ -341,32 +598,37 @@ func (s *ExecutorServer) ExecutePipeline(ctx context.Context, req *executorv1.Pi
 		Success:     true,
 		TotalSteps:  int32(len(req.Steps)),
 		StepResults: make([]*executorv1.StepResult, 0, len(req.Steps)),
+		Outputs:     make(map[string]string),
 	}
 
+	if req.CaptureGitSha {
+		response.GitSha = s.gitSHA(ctx, req.WorkspaceDir)
+	}
+	s.prepullImages(ctx, req.Steps, nil)
+
+	// Output bytes retained so far across all steps
+	var outputBytes int64
+
+	response.StopReason = executorv1.StopReason_STOP_REASON_COMPLETED
+
 	// Execute each step
 	for i, step := range req.Steps {
 		// Check context cancellation
 		if ctx.Err() != nil {
 			response.Success = false
 			response.FailedStep = step.Name
+			response.StopReason = contextStopReason(ctx)
+			response.StepResults = append(response.StepResults, skippedSteps(req.Steps, i, pipelineStopReason(ctx))...)
 			break
 		}
 
-		// Build execute request for step
-		execReq := &executorv1.ExecuteRequest{
-			Tool:           step.Tool,
-			Args:           step.Args,
-			Env:            append(req.Env, step.Env...),
-			TimeoutSeconds: step.TimeoutSeconds,
-		}
-
-		// Set working directory
-		if step.WorkDir != "" && req.WorkspaceDir != "" {
-			execReq.WorkDir = req.WorkspaceDir + "/" + step.WorkDir
-		} else if step.WorkDir != "" {
-			execReq.WorkDir = step.WorkDir
-		} else if req.WorkspaceDir != "" {
-			execReq.WorkDir = req.WorkspaceDir
+		if unchanged[i] {
+			s.logger.Info("skipping unchanged pipeline step",
+				zap.String("pipeline_id", pipelineID),
+				zap.String("step", step.Name),
+			)
+			response.StepResults = append(response.StepResults, unchangedStep(step, i))
+			continue
 		}
 
 		s.logger.Info("executing pipeline step",
@@ -375,12 +637,14 @@ func (s *ExecutorServer) ExecutePipeline(ctx context.Context, req *executorv1.Pi
 			zap.String("step_name", step.Name),
 		)
 
-		execResult, err := s.Execute(ctx, execReq)
-
+		scope := &pipelineScope{pipelineID: pipelineID, stepName: step.Name, stepIndex: i, gitSHA: response.GitSha, outputs: response.Outputs}
 		stepResult := &executorv1.StepResult{
 			Name:      step.Name,
 			StepIndex: int32(i),
 		}
+		stepStart := time.Now()
+		execResult, err := s.executeStep(ctx, req, step, scope, stepResult, nil)
+		stepEnd := time.Now()
 
 		if err != nil {
 			stepResult.ExecuteResult = &executorv1.ExecuteResponse{
@@ -397,11 +661,28 @@ func (s *ExecutorServer) ExecutePipeline(ctx context.Context, req *executorv1.Pi
 			}
 		}
 
+		// A pipeline timeout or cancellation also ends the active step
+		interrupted := markInterrupted(ctx, stepResult)
+		if interrupted {
+			response.Success = false
+			response.FailedStep = step.Name
+			response.StopReason = contextStopReason(ctx)
+		}
+
+		s.capStepOutput(stepResult, &outputBytes)
+		addStepTimings(response, stepResult)
+		addGraphNode(response, stepResult, stepStart, stepEnd, inputs[i])
 		response.StepResults = append(response.StepResults, stepResult)
-		response.CompletedSteps = int32(i + 1)
+		countStep(response, stepResult)
+
+		if interrupted {
+			response.StepResults = append(response.StepResults, skippedSteps(req.Steps, i+1, pipelineStopReason(ctx))...)
+			break
+		}
 
 		// Stop if step failed and not configured to continue
 		if !response.Success && !step.ContinueOnError {
+			response.StopReason = stepStopReason(err, stepResult.ExecuteResult)
 			break
 		}
 	}
@@ -421,13 +702,46 @@ func (s *ExecutorServer) ExecutePipeline(ctx context.Context, req *executorv1.Pi
 
 // ExecutePipelineStream runs a pipeline and streams step outputs
 func (s *ExecutorServer) ExecutePipelineStream(req *executorv1.PipelineRequest, stream executorv1.ExecutorService_ExecutePipelineStreamServer) error {
+	if err := s.checkPipelineSteps(req); err != nil {
+		return err
+	}
+	req, err := s.resolveSteps(req)
+	if err != nil {
+		return err
+	}
+	if err := s.checkTenantDir(stream.Context(), "workspace_dir", req.WorkspaceDir); err != nil {
+		return err
+	}
+	inputs, err := planArtifacts(req.Steps)
+	if err != nil {
+		return err
+	}
+	unchanged, err := planChanged(req)
+	if err != nil {
+		return err
+	}
+	if err := s.checkFence(stream.Context(), req.JobKey, req.FenceToken); err != nil {
+		return err
+	}
+
+	release, err := s.acquireStream()
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	startTime := time.Now()
 	ctx := stream.Context()
 
 	pipelineID := req.Id
 	if pipelineID == "" {
-		pipelineID = uuid.New().String()
+		pipelineID = s.ids.newID()
+	}
+	if err := s.checkPipelineOwner(ctx, pipelineID); err != nil {
+		return err
 	}
+	stream, finishReplay := s.recordPipeline(pipelineID, stream)
+	defer finishReplay()
 
 	s.logger.Info("starting pipeline stream",
 		zap.String("pipeline_id", pipelineID),
@@ -449,6 +763,7 @@ func (s *ExecutorServer) ExecutePipelineStream(req *executorv1.PipelineRequest,
 	runningPipeline := &RunningPipeline{
 		ID:        pipelineID,
 		Name:      req.Name,
+		Tenant:    callerTenant(ctx),
 		Cancel:    cancel,
 		StartedAt: startTime,
 	}
@@ -470,16 +785,53 @@ func (s *ExecutorServer) ExecutePipelineStream(req *executorv1.PipelineRequest,
 		Success:     true,
 		TotalSteps:  int32(len(req.Steps)),
 		StepResults: make([]*executorv1.StepResult, 0, len(req.Steps)),
+		Outputs:     make(map[string]string),
 	}
 
+	if req.CaptureGitSha {
+		response.GitSha = s.gitSHA(ctx, req.WorkspaceDir)
+	}
+	s.prepullImages(ctx, req.Steps, func(event *executorv1.ImagePullEvent) {
+		stream.Send(&executorv1.PipelineStreamResponse{
+			Event: &executorv1.PipelineStreamResponse_ImagePull{ImagePull: event},
+		})
+	})
+
+	// Output bytes retained so far across all steps
+	var outputBytes int64
+
+	response.StopReason = executorv1.StopReason_STOP_REASON_COMPLETED
+
 	// Execute each step
 	for i, step := range req.Steps {
 		if ctx.Err() != nil {
 			response.Success = false
 			response.FailedStep = step.Name
+			response.StopReason = contextStopReason(ctx)
+			if err := sendPipelineTimeout(ctx, stream, req, startTime, nil, -1); err != nil {
+				return err
+			}
+			if err := s.sendSkippedSteps(stream, response, req.Steps, i, pipelineStopReason(ctx)); err != nil {
+				return err
+			}
 			break
 		}
 
+		if unchanged[i] {
+			s.logger.Info("skipping unchanged pipeline step",
+				zap.String("pipeline_id", pipelineID),
+				zap.String("step", step.Name),
+			)
+			skipped := unchangedStep(step, i)
+			response.StepResults = append(response.StepResults, skipped)
+			if err := stream.Send(&executorv1.PipelineStreamResponse{
+				Event: &executorv1.PipelineStreamResponse_StepCompleted{StepCompleted: skipped},
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
 		// Send step started event
 		stepStarted := &executorv1.StepStartedEvent{
 			StepName:   step.Name,
@@ -497,9 +849,25 @@ func (s *ExecutorServer) ExecutePipelineStream(req *executorv1.PipelineRequest,
 		}
 
 		// Execute step with output streaming
-		stepResult := s.executeStepWithStreaming(ctx, req, step, i, stream)
+		scope := &pipelineScope{pipelineID: pipelineID, stepName: step.Name, stepIndex: i, gitSHA: response.GitSha, outputs: response.Outputs}
+		stepStart := time.Now()
+		stepResult, stepErr := s.executeStepWithStreaming(ctx, req, step, scope, stream)
+		stepEnd := time.Now()
+
+		// A pipeline timeout or cancellation also ends the active step
+		interrupted := markInterrupted(ctx, stepResult)
+
+		if limit := s.capStepOutput(stepResult, &outputBytes); limit != nil {
+			if err := stream.Send(&executorv1.PipelineStreamResponse{
+				Event: &executorv1.PipelineStreamResponse_Limit{Limit: limit},
+			}); err != nil {
+				return err
+			}
+		}
+		addStepTimings(response, stepResult)
+		addGraphNode(response, stepResult, stepStart, stepEnd, inputs[i])
 		response.StepResults = append(response.StepResults, stepResult)
-		response.CompletedSteps = int32(i + 1)
+		countStep(response, stepResult)
 
 		// Send step completed event
 		if err := stream.Send(&executorv1.PipelineStreamResponse{
@@ -510,11 +878,25 @@ func (s *ExecutorServer) ExecutePipelineStream(req *executorv1.PipelineRequest,
 			return err
 		}
 
+		if interrupted {
+			response.Success = false
+			response.FailedStep = step.Name
+			response.StopReason = contextStopReason(ctx)
+			if err := sendPipelineTimeout(ctx, stream, req, startTime, step, i); err != nil {
+				return err
+			}
+			if err := s.sendSkippedSteps(stream, response, req.Steps, i+1, pipelineStopReason(ctx)); err != nil {
+				return err
+			}
+			break
+		}
+
 		// Check if we should stop
 		if stepResult.ExecuteResult != nil && !stepResult.ExecuteResult.Success {
 			if !step.ContinueOnError {
 				response.Success = false
 				response.FailedStep = step.Name
+				response.StopReason = stepStopReason(stepErr, stepResult.ExecuteResult)
 				break
 			}
 		}
@@ -532,163 +914,387 @@ func (s *ExecutorServer) ExecutePipelineStream(req *executorv1.PipelineRequest,
 	})
 }
 
-// executeStepWithStreaming executes a step and streams its output
-func (s *ExecutorServer) executeStepWithStreaming(
+// checkPipelineSteps rejects pipelines with more than
+// executor.max_pipeline_steps steps
+func (s *ExecutorServer) checkPipelineSteps(req *executorv1.PipelineRequest) error {
+	if limit := s.config.MaxPipelineSteps; limit > 0 && len(req.Steps) > limit {
+		return invalidField("steps", "pipeline has %d steps, more than the limit of %d", len(req.Steps), limit)
+	}
+	return nil
+}
+
+// executeStep validates and runs a single pipeline step with its before and
+// after commands, retrying the step's command as the step allows. It returns
+// the last attempt's result and records the attempts and the before and after
+// results in result.
+func (s *ExecutorServer) executeStep(
 	ctx context.Context,
 	pipelineReq *executorv1.PipelineRequest,
 	step *executorv1.BuildStep,
-	stepIndex int,
-	stream executorv1.ExecutorService_ExecutePipelineStreamServer,
-) *executorv1.StepResult {
-	result := &executorv1.StepResult{
-		Name:      step.Name,
-		StepIndex: int32(stepIndex),
+	scope *pipelineScope,
+	result *executorv1.StepResult,
+	observe stepObservers,
+) (*executorv1.ExecuteResponse, error) {
+	stepReq, err := s.resolveShell(stepRequest(pipelineReq, step))
+	if err != nil {
+		return nil, err
 	}
-
-	// Build command
-	cmd := exec.CommandContext(ctx, step.Tool, step.Args...)
-
-	// Set working directory
-	if step.WorkDir != "" && pipelineReq.WorkspaceDir != "" {
-		cmd.Dir = pipelineReq.WorkspaceDir + "/" + step.WorkDir
-	} else if step.WorkDir != "" {
-		cmd.Dir = step.WorkDir
-	} else if pipelineReq.WorkspaceDir != "" {
-		cmd.Dir = pipelineReq.WorkspaceDir
+	if err := s.validateTool(ctx, stepReq); err != nil {
+		return nil, err
 	}
-
-	// Set environment
-	if len(pipelineReq.Env) > 0 || len(step.Env) > 0 {
-		cmd.Env = append(cmd.Environ(), pipelineReq.Env...)
-		cmd.Env = append(cmd.Env, step.Env...)
+	execReq := stepReq
+	if step.Image != "" {
+		if execReq, err = s.containerize(stepReq, pipelineReq.WorkspaceDir, step.Image); err != nil {
+			return nil, err
+		}
 	}
-
-	// Create pipes
-	stdout, err := cmd.StdoutPipe()
+	expect, err := newStepExpectation(step)
 	if err != nil {
-		result.ExecuteResult = &executorv1.ExecuteResponse{Success: false, Error: err.Error()}
-		return result
+		return nil, err
 	}
-
-	stderr, err := cmd.StderrPipe()
+	if err := validateVerifyFiles(step); err != nil {
+		return nil, err
+	}
+	if err := validateTestReport(step); err != nil {
+		return nil, err
+	}
+	if step.CaptureLastLineAs != "" && !templateName.MatchString(step.CaptureLastLineAs) {
+		return nil, invalidField("capture_last_line_as", "capture_last_line_as '%s' is not a valid variable name", step.CaptureLastLineAs)
+	}
+	before, err := s.stepCommandRequests(ctx, stepRequest(pipelineReq, step), pipelineReq.WorkspaceDir, step, step.Before, "before")
 	if err != nil {
-		result.ExecuteResult = &executorv1.ExecuteResponse{Success: false, Error: err.Error()}
-		return result
+		return nil, err
+	}
+	after, err := s.stepCommandRequests(ctx, stepRequest(pipelineReq, step), pipelineReq.WorkspaceDir, step, step.After, "after")
+	if err != nil {
+		return nil, err
 	}
 
-	// Start command
-	stepStartTime := time.Now()
-	processID := uuid.New().String()
+	// A failing before command takes the place of the step's command
+	var response *executorv1.ExecuteResponse
+	failed, err := s.runStepCommands(ctx, before, scope, observe.phase(phaseBefore), &result.BeforeResults)
+	if failed != nil {
+		response = &executorv1.ExecuteResponse{
+			ProcessId: failed.ProcessId,
+			ExitCode:  failed.ExitCode,
+			TimedOut:  failed.TimedOut,
+			ErrorCode: executorv1.ErrorCode_ERROR_CODE_STEP_COMMAND_FAILED,
+			Error:     stepCommandFailure(phaseBefore, failed),
+		}
+	} else if missing := missingArtifact(pipelineReq.WorkspaceDir, step.Consumes); err == nil && missing != "" {
+		response = &executorv1.ExecuteResponse{
+			ErrorCode: executorv1.ErrorCode_ERROR_CODE_ARTIFACT_MISSING,
+			Error:     fmt.Sprintf("consumed file %s does not exist", missing),
+		}
+	} else if err == nil {
+		response, err = s.retryStep(ctx, execReq, pipelineReq, step, expect, scope, result, observe.phase(""))
+		if err == nil && step.TestReport != nil {
+			result.TestReport = parseTestReport(step.TestReport, stepReq.WorkDir)
+		}
+	}
 
-	if err := cmd.Start(); err != nil {
-		result.ExecuteResult = &executorv1.ExecuteResponse{Success: false, Error: err.Error()}
-		return result
+	// After commands run whatever happened to the step, unless the pipeline
+	// itself was stopped
+	if ctx.Err() != nil {
+		return response, err
+	}
+	if err == nil && response.Success && step.CaptureLastLineAs != "" {
+		scope.outputs[step.CaptureLastLineAs] = lastLine(response.Stdout)
 	}
 
-	// Track running process
-	ctx, cancel := context.WithCancel(ctx)
-	runningProc := &RunningProcess{
-		ID:         processID,
-		Tool:       step.Tool,
-		Args:       step.Args,
-		Command:    cmd,
-		Cancel:     cancel,
-		StartedAt:  stepStartTime,
-		PipelineID: pipelineReq.Id,
+	failed, afterErr := s.runStepCommands(ctx, after, scope, observe.phase(phaseAfter), &result.AfterResults)
+	if err != nil {
+		return nil, err
+	}
+	if afterErr != nil {
+		return nil, afterErr
+	}
+	if failed != nil && response.Success {
+		response.Success = false
+		response.ErrorCode = executorv1.ErrorCode_ERROR_CODE_STEP_COMMAND_FAILED
+		response.Error = stepCommandFailure(phaseAfter, failed)
 	}
+	return response, nil
+}
 
-	s.mu.Lock()
-	s.running[processID] = runningProc
-	s.mu.Unlock()
+// retryStep runs a step's command, retrying it as the step allows, and
+// returns the last attempt's result
+func (s *ExecutorServer) retryStep(
+	ctx context.Context,
+	execReq *executorv1.ExecuteRequest,
+	pipelineReq *executorv1.PipelineRequest,
+	step *executorv1.BuildStep,
+	expect *stepExpectation,
+	scope *pipelineScope,
+	result *executorv1.StepResult,
+	obs *observer,
+) (*executorv1.ExecuteResponse, error) {
+	for {
+		result.Attempts++
+		response, err := s.runStepAttempt(ctx, execReq, pipelineReq, step, expect, scope, obs)
+		if err != nil {
+			return nil, err
+		}
 
-	defer func() {
-		s.mu.Lock()
-		delete(s.running, processID)
-		s.mu.Unlock()
-	}()
+		if result.Attempts > step.MaxRetries || !shouldRetry(ctx, step, response) {
+			return response, nil
+		}
 
-	// Stream output
-	var wg sync.WaitGroup
-	var stdoutBuf, stderrBuf strings.Builder
+		delay := retryDelay(step, result.Attempts)
+		s.logger.Info("retrying pipeline step",
+			zap.String("pipeline_id", scope.pipelineID),
+			zap.String("step_name", step.Name),
+			zap.Int32("exit_code", response.ExitCode),
+			zap.Int32("attempt", result.Attempts+1),
+			zap.Duration("backoff", delay),
+		)
 
-	wg.Add(2)
-	go func() {
-		defer wg.Done()
-		s.streamPipelineOutput(stdout, &stdoutBuf, stream, step.Name, int32(stepIndex), true)
-	}()
-	go func() {
-		defer wg.Done()
-		s.streamPipelineOutput(stderr, &stderrBuf, stream, step.Name, int32(stepIndex), false)
-	}()
+		select {
+		case <-ctx.Done():
+			return response, nil
+		case <-time.After(delay):
+		}
+	}
+}
 
-	wg.Wait()
+// runStepAttempt runs a pipeline step once
+func (s *ExecutorServer) runStepAttempt(
+	ctx context.Context,
+	execReq *executorv1.ExecuteRequest,
+	pipelineReq *executorv1.PipelineRequest,
+	step *executorv1.BuildStep,
+	expect *stepExpectation,
+	scope *pipelineScope,
+	obs *observer,
+) (*executorv1.ExecuteResponse, error) {
+	// Give the step its share of the remaining pipeline budget
+	if deadline, ok := ctx.Deadline(); ok && step.TimeoutFraction > 0 {
+		budget := time.Duration(float64(time.Until(deadline)) * min(step.TimeoutFraction, 1))
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, budget)
+		defer cancel()
+	}
 
-	// Wait for command
-	err = cmd.Wait()
-	endTime := time.Now()
-	duration := endTime.Sub(stepStartTime)
+	response, err := s.run(ctx, execReq, scope, obs)
+	if err != nil {
+		return nil, err
+	}
 
-	execResult := &executorv1.ExecuteResponse{
-		ProcessId:  processID,
-		Tool:       step.Tool,
-		Args:       step.Args,
-		Stdout:     stdoutBuf.String(),
-		Stderr:     stderrBuf.String(),
-		DurationMs: duration.Milliseconds(),
-		StartedAt:  timestamppb.New(stepStartTime),
-		EndedAt:    timestamppb.New(endTime),
+	// Any stderr output fails the step, continue_on_error still applies
+	if (step.FailOnStderr || pipelineReq.FailOnStderr) && response.Success && response.StderrBytes > 0 {
+		response.Success = false
+		response.ErrorCode = executorv1.ErrorCode_ERROR_CODE_STDERR_OUTPUT
+		response.Error = fmt.Sprintf("step wrote %d bytes to stderr", response.StderrBytes)
 	}
 
-	if err != nil {
-		if ctx.Err() == context.DeadlineExceeded {
-			execResult.ExitCode = -1
-			execResult.Error = "command timed out"
-			execResult.TimedOut = true
-		} else if exitErr, ok := err.(*exec.ExitError); ok {
-			execResult.ExitCode = int32(exitErr.ExitCode())
-			execResult.Error = exitErr.Error()
-		} else {
-			execResult.ExitCode = -1
-			execResult.Error = err.Error()
+	expect.check(response)
+	verifyFiles(step, execReq.WorkDir, response)
+	checkProduced(step, pipelineReq.WorkspaceDir, response)
+	return response, nil
+}
+
+// executeStepWithStreaming executes a step and streams its output. An error
+// that kept the step from running is recorded in the result and returned.
+func (s *ExecutorServer) executeStepWithStreaming(
+	ctx context.Context,
+	pipelineReq *executorv1.PipelineRequest,
+	step *executorv1.BuildStep,
+	scope *pipelineScope,
+	stream executorv1.ExecutorService_ExecutePipelineStreamServer,
+) (*executorv1.StepResult, error) {
+	result := &executorv1.StepResult{
+		Name:      step.Name,
+		StepIndex: int32(scope.stepIndex),
+	}
+
+	// stdout and stderr are read concurrently but a stream allows one sender
+	sender := &streamSender[*executorv1.PipelineStreamResponse]{send: stream.Send, logger: s.logger}
+
+	execResult, err := s.executeStep(ctx, pipelineReq, step, scope, result, func(phase string) *observer {
+		return &observer{
+			line: func(line string, isStdout bool) {
+				event := &executorv1.StepOutputEvent{
+					StepName:  step.Name,
+					StepIndex: int32(scope.stepIndex),
+					Phase:     phase,
+				}
+
+				if isStdout {
+					event.Output = &executorv1.StepOutputEvent_StdoutLine{StdoutLine: line}
+				} else {
+					event.Output = &executorv1.StepOutputEvent_StderrLine{StderrLine: line}
+				}
+
+				sender.Send(&executorv1.PipelineStreamResponse{
+					Event: &executorv1.PipelineStreamResponse_StepOutput{
+						StepOutput: event,
+					},
+				})
+			},
+			limit: func(event *executorv1.LimitEvent) {
+				event.StepName = step.Name
+				event.StepIndex = int32(scope.stepIndex)
+				sender.Send(&executorv1.PipelineStreamResponse{
+					Event: &executorv1.PipelineStreamResponse_Limit{Limit: event},
+				})
+			},
+			deadline: func(event *executorv1.DeadlineEvent) {
+				event.StepName = step.Name
+				event.StepIndex = int32(scope.stepIndex)
+				sender.Send(&executorv1.PipelineStreamResponse{
+					Event: &executorv1.PipelineStreamResponse_Deadline{Deadline: event},
+				})
+			},
+			progress: func(event *executorv1.ProgressEvent) {
+				event.StepName = step.Name
+				event.StepIndex = int32(scope.stepIndex)
+				sender.Send(&executorv1.PipelineStreamResponse{
+					Event: &executorv1.PipelineStreamResponse_Progress{Progress: event},
+				})
+			},
+			env: func(event *executorv1.EnvSnapshotEvent) {
+				event.StepName = step.Name
+				event.StepIndex = int32(scope.stepIndex)
+				sender.Send(&executorv1.PipelineStreamResponse{
+					Event: &executorv1.PipelineStreamResponse_EnvSnapshot{EnvSnapshot: event},
+				})
+			},
+			rateLimited: func(event *executorv1.RateLimitedEvent) {
+				event.StepName = step.Name
+				event.StepIndex = int32(scope.stepIndex)
+				sender.Send(&executorv1.PipelineStreamResponse{
+					Event: &executorv1.PipelineStreamResponse_RateLimited{RateLimited: event},
+				})
+			},
+			chunk: func(data []byte, isStdout bool) {
+				event := &executorv1.StepOutputEvent{
+					StepName:  step.Name,
+					StepIndex: int32(scope.stepIndex),
+					Phase:     phase,
+				}
+
+				if isStdout {
+					event.Output = &executorv1.StepOutputEvent_StdoutChunk{StdoutChunk: data}
+				} else {
+					event.Output = &executorv1.StepOutputEvent_StderrChunk{StderrChunk: data}
+				}
+
+				sender.Send(&executorv1.PipelineStreamResponse{
+					Event: &executorv1.PipelineStreamResponse_StepOutput{
+						StepOutput: event,
+					},
+				})
+			},
 		}
-		execResult.Success = false
-	} else {
-		execResult.ExitCode = 0
-		execResult.Success = true
+	})
+	if err != nil {
+		result.ExecuteResult = &executorv1.ExecuteResponse{Success: false, Error: err.Error()}
+		return result, err
 	}
 
 	result.ExecuteResult = execResult
-	return result
+	return result, nil
 }
 
 // CancelProcess cancels a running process by ID
 func (s *ExecutorServer) CancelProcess(ctx context.Context, req *executorv1.CancelRequest) (*executorv1.CancelResponse, error) {
+	sig := s.cancelSignal
+	if req.CancelSignal != "" {
+		var err error
+		if sig, err = signals.Parse(req.CancelSignal); err != nil {
+			return nil, invalidField("cancel_signal", "invalid cancel_signal: %v", err)
+		}
+	}
+
 	s.mu.RLock()
 	proc, exists := s.running[req.ProcessId]
 	s.mu.RUnlock()
 
-	if !exists {
+	// Other tenants' processes are reported as not found
+	if !exists || proc.Tenant != callerTenant(ctx) {
 		return &executorv1.CancelResponse{
 			Success: false,
 			Message: fmt.Sprintf("process %s not found", req.ProcessId),
+			State:   executorv1.CancelState_CANCEL_STATE_NOT_FOUND,
 		}, nil
 	}
+	if proc.exited.Load() {
+		return &executorv1.CancelResponse{
+			Success: false,
+			Message: fmt.Sprintf("process %s has already exited", req.ProcessId),
+			State:   executorv1.CancelState_CANCEL_STATE_ALREADY_EXITED,
+		}, nil
+	}
+
+	s.logger.Info("cancelling process",
+		append([]zap.Field{
+			zap.String("process_id", req.ProcessId),
+			zap.String("tool", proc.Tool),
+			zap.Stringer("signal", sig),
+		}, argsFields(proc.Args, proc.logArgs)...)...)
 
-	proc.Cancel()
+	proc.Terminate(sig)
 
+	if proc.awaitExit(ctx, min(time.Duration(req.WaitMs)*time.Millisecond, maxCancelWait)) {
+		return &executorv1.CancelResponse{
+			Success: true,
+			Message: fmt.Sprintf("process %s terminated", req.ProcessId),
+			State:   executorv1.CancelState_CANCEL_STATE_TERMINATED,
+		}, nil
+	}
 	return &executorv1.CancelResponse{
 		Success: true,
 		Message: fmt.Sprintf("process %s cancelled", req.ProcessId),
+		State:   executorv1.CancelState_CANCEL_STATE_SIGNALED,
 	}, nil
 }
 
+// CancelByTool cancels every running process of a tool
+func (s *ExecutorServer) CancelByTool(ctx context.Context, req *executorv1.CancelByToolRequest) (*executorv1.CancelByToolResponse, error) {
+	if req.Tool == "" {
+		return nil, invalidField("tool", "tool is required")
+	}
+
+	sig := s.cancelSignal
+	if req.CancelSignal != "" {
+		var err error
+		if sig, err = signals.Parse(req.CancelSignal); err != nil {
+			return nil, invalidField("cancel_signal", "invalid cancel_signal: %v", err)
+		}
+	}
+
+	tenant := callerTenant(ctx)
+	s.mu.RLock()
+	var matched []*RunningProcess
+	for _, proc := range s.running {
+		if proc.Tenant == tenant && proc.matchesTool(req.Tool) {
+			matched = append(matched, proc)
+		}
+	}
+	s.mu.RUnlock()
+
+	response := &executorv1.CancelByToolResponse{ProcessIds: make([]string, 0, len(matched))}
+	for _, proc := range matched {
+		proc.Terminate(sig)
+		response.ProcessIds = append(response.ProcessIds, proc.ID)
+	}
+
+	s.logger.Info("cancelled processes by tool",
+		zap.String("tool", req.Tool),
+		zap.Stringer("signal", sig),
+		zap.Strings("process_ids", response.ProcessIds),
+	)
+
+	return response, nil
+}
+
 // CancelPipeline cancels a running pipeline by ID
 func (s *ExecutorServer) CancelPipeline(ctx context.Context, req *executorv1.CancelPipelineRequest) (*executorv1.CancelPipelineResponse, error) {
 	s.mu.RLock()
 	pipeline, exists := s.pipelines[req.PipelineId]
 	s.mu.RUnlock()
 
-	if !exists {
+	if !exists || pipeline.Tenant != callerTenant(ctx) {
 		s.logger.Warn("pipeline not found for cancellation",
 			zap.String("pipeline_id", req.PipelineId))
 		return &executorv1.CancelPipelineResponse{
@@ -731,14 +1337,22 @@ func (s *ExecutorServer) GetRunningProcesses(ctx context.Context, req *executorv
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	tenant := callerTenant(ctx)
 	processes := make([]*executorv1.ProcessInfo, 0, len(s.running))
 	for _, proc := range s.running {
+		if proc.Tenant != tenant || (req.Tool != "" && !proc.matchesTool(req.Tool)) {
+			continue
+		}
+		stdoutBytes, stderrBytes := proc.OutputBytes()
 		processes = append(processes, &executorv1.ProcessInfo{
-			ProcessId:  proc.ID,
-			Tool:       proc.Tool,
-			Args:       proc.Args,
-			StartedAt:  timestamppb.New(proc.StartedAt),
-			DurationMs: time.Since(proc.StartedAt).Milliseconds(),
+			ProcessId:   proc.ID,
+			OsPid:       int32(proc.Command.Process.Pid),
+			Tool:        proc.Tool,
+			Args:        proc.Args,
+			StartedAt:   timestamppb.New(proc.StartedAt),
+			DurationMs:  time.Since(proc.StartedAt).Milliseconds(),
+			StdoutBytes: stdoutBytes,
+			StderrBytes: stderrBytes,
 		})
 	}
 
@@ -748,94 +1362,260 @@ func (s *ExecutorServer) GetRunningProcesses(ctx context.Context, req *executorv
 	}, nil
 }
 
+// Ping returns the current time without touching any server state
+func (s *ExecutorServer) Ping(ctx context.Context, req *executorv1.PingRequest) (*executorv1.PingResponse, error) {
+	return &executorv1.PingResponse{Time: timestamppb.Now()}, nil
+}
+
 // Health returns the service health status
 func (s *ExecutorServer) Health(ctx context.Context, req *executorv1.HealthRequest) (*executorv1.HealthResponse, error) {
 	s.mu.RLock()
 	runningCount := len(s.running)
 	s.mu.RUnlock()
 
+	throttleReason := s.checkPressure()
+
+	toolChecks, failing := s.toolCheckResults()
+	healthStatus := "healthy"
+	if failing {
+		healthStatus = "degraded"
+	}
+
 	return &executorv1.HealthResponse{
-		Status:        "healthy",
-		Version:       "0.1.0",
-		RunningCount:  int32(runningCount),
-		MaxConcurrent: int32(s.config.MaxConcurrent),
-		UptimeSeconds: time.Since(startTime).Seconds(),
-		CheckedAt:     timestamppb.Now(),
+		Status:         healthStatus,
+		Version:        "0.1.0",
+		RunningCount:   int32(runningCount),
+		MaxConcurrent:  int32(s.config.MaxConcurrent),
+		UptimeSeconds:  time.Since(startTime).Seconds(),
+		CheckedAt:      timestamppb.Now(),
+		Throttled:      throttleReason != "",
+		ThrottleReason: throttleReason,
+		Limits: &executorv1.ServerLimits{
+			MaxMessageBytes:        MaxMessageBytes,
+			MaxOutputBytes:         s.config.MaxOutputBytes,
+			MaxLineBytes:           int64(s.config.MaxLineBytes),
+			MaxPipelineOutputBytes: s.config.MaxPipelineOutputBytes,
+			MaxConcurrentStreams:   int32(s.config.MaxConcurrentStreams),
+			MaxConnections:         int32(s.maxConnections),
+			MaxPipelineSteps:       int32(s.config.MaxPipelineSteps),
+		},
+		ConnectionCount: int32(s.connections.Load()),
+		GoroutineCount:  int32(runtime.NumGoroutine()),
+		OpenFdCount:     int32(openFDCount()),
+		ToolChecks:      toolChecks,
 	}, nil
 }
 
 // Helper functions
 
-func (s *ExecutorServer) readOutput(r io.Reader, buf *strings.Builder) {
-	scanner := bufio.NewScanner(r)
-	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
-
-	for scanner.Scan() {
-		buf.WriteString(scanner.Text())
-		buf.WriteString("\n")
+// openFDCount returns the number of open file descriptors, or 0 where
+// /proc/self/fd is unavailable
+func openFDCount() int {
+	entries, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		return 0
 	}
+	// Reading the directory holds one descriptor itself
+	return len(entries) - 1
 }
 
-func (s *ExecutorServer) streamOutput(r io.Reader, buf *strings.Builder, stream executorv1.ExecutorService_ExecuteStreamServer, isStdout bool) {
-	scanner := bufio.NewScanner(r)
-	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+// countStep counts a step that ran as attempted and, if it succeeded, as
+// completed
+func countStep(response *executorv1.PipelineResponse, result *executorv1.StepResult) {
+	response.AttemptedSteps++
+	if result.ExecuteResult.GetSuccess() {
+		response.CompletedSteps++
+	}
+}
 
-	for scanner.Scan() {
-		line := scanner.Text()
-		buf.WriteString(line)
-		buf.WriteString("\n")
+// pipelineStopReason describes why a pipeline context ended
+func pipelineStopReason(ctx context.Context) string {
+	if ctx.Err() == context.DeadlineExceeded {
+		return "pipeline timed out"
+	}
+	return "pipeline cancelled"
+}
 
-		var msg *executorv1.ExecuteStreamResponse
-		if isStdout {
-			msg = &executorv1.ExecuteStreamResponse{
-				Output: &executorv1.ExecuteStreamResponse_StdoutLine{
-					StdoutLine: line,
-				},
-			}
-		} else {
-			msg = &executorv1.ExecuteStreamResponse{
-				Output: &executorv1.ExecuteStreamResponse_StderrLine{
-					StderrLine: line,
-				},
-			}
-		}
+// contextStopReason returns why a pipeline whose context ended stopped
+func contextStopReason(ctx context.Context) executorv1.StopReason {
+	if ctx.Err() == context.DeadlineExceeded {
+		return executorv1.StopReason_STOP_REASON_TIMEOUT
+	}
+	return executorv1.StopReason_STOP_REASON_CANCELLED
+}
 
-		if err := stream.Send(msg); err != nil {
-			s.logger.Warn("failed to stream output", zap.Error(err))
-			return
-		}
+// stepStopReason returns why a failed step stopped its pipeline, given the
+// error that kept it from running, if any, and its result
+func stepStopReason(err error, result *executorv1.ExecuteResponse) executorv1.StopReason {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.PermissionDenied, codes.FailedPrecondition:
+		return executorv1.StopReason_STOP_REASON_VALIDATION
+	}
+	if result != nil && result.TimedOut {
+		return executorv1.StopReason_STOP_REASON_STEP_TIMEOUT
 	}
+	return executorv1.StopReason_STOP_REASON_STEP_FAILED
 }
 
-func (s *ExecutorServer) streamPipelineOutput(r io.Reader, buf *strings.Builder, stream executorv1.ExecutorService_ExecutePipelineStreamServer, stepName string, stepIndex int32, isStdout bool) {
-	scanner := bufio.NewScanner(r)
-	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+// sendPipelineTimeout sends a PipelineTimeoutEvent if the pipeline context
+// ended because its timeout expired while step, or no step if nil, was active
+func sendPipelineTimeout(
+	ctx context.Context,
+	stream executorv1.ExecutorService_ExecutePipelineStreamServer,
+	req *executorv1.PipelineRequest,
+	startTime time.Time,
+	step *executorv1.BuildStep,
+	index int,
+) error {
+	if ctx.Err() != context.DeadlineExceeded {
+		return nil
+	}
 
-	for scanner.Scan() {
-		line := scanner.Text()
-		buf.WriteString(line)
-		buf.WriteString("\n")
+	event := &executorv1.PipelineTimeoutEvent{
+		TimeoutSeconds:  req.TimeoutSeconds,
+		ElapsedMs:       time.Since(startTime).Milliseconds(),
+		ActiveStepIndex: int32(index),
+	}
+	if step != nil {
+		event.ActiveStepName = step.Name
+	}
+	return stream.Send(&executorv1.PipelineStreamResponse{
+		Event: &executorv1.PipelineStreamResponse_PipelineTimeout{PipelineTimeout: event},
+	})
+}
 
-		event := &executorv1.StepOutputEvent{
-			StepName:  stepName,
-			StepIndex: stepIndex,
-		}
+// markInterrupted flags a step that was cut short because the pipeline timed
+// out or was cancelled while it ran, and reports whether that happened. A
+// step that succeeded or exited on its own before the pipeline ended keeps
+// its result; the pipeline then stops before the next step.
+func markInterrupted(ctx context.Context, result *executorv1.StepResult) bool {
+	if ctx.Err() == nil {
+		return false
+	}
 
-		if isStdout {
-			event.Output = &executorv1.StepOutputEvent_StdoutLine{StdoutLine: line}
-		} else {
-			event.Output = &executorv1.StepOutputEvent_StderrLine{StderrLine: line}
-		}
+	// Killed steps exit with -1, steps that never started have no start time
+	res := result.ExecuteResult
+	if res != nil && (res.Success || (res.ExitCode >= 0 && res.StartedAt != nil)) {
+		return false
+	}
+	if res != nil {
+		res.Error = pipelineStopReason(ctx)
+		res.TimedOut = ctx.Err() == context.DeadlineExceeded
+	}
+	return true
+}
 
-		msg := &executorv1.PipelineStreamResponse{
-			Event: &executorv1.PipelineStreamResponse_StepOutput{
-				StepOutput: event,
+// skippedSteps returns skipped results for the steps from index onwards
+func skippedSteps(steps []*executorv1.BuildStep, from int, reason string) []*executorv1.StepResult {
+	results := make([]*executorv1.StepResult, 0, len(steps)-from)
+	for i := from; i < len(steps); i++ {
+		results = append(results, &executorv1.StepResult{
+			Name:       steps[i].Name,
+			StepIndex:  int32(i),
+			Skipped:    true,
+			SkipReason: reason,
+		})
+	}
+	return results
+}
+
+// sendSkippedSteps records the steps from index onwards as skipped and
+// streams a completed event for each
+func (s *ExecutorServer) sendSkippedSteps(
+	stream executorv1.ExecutorService_ExecutePipelineStreamServer,
+	response *executorv1.PipelineResponse,
+	steps []*executorv1.BuildStep,
+	from int,
+	reason string,
+) error {
+	for _, skipped := range skippedSteps(steps, from, reason) {
+		response.StepResults = append(response.StepResults, skipped)
+		if err := stream.Send(&executorv1.PipelineStreamResponse{
+			Event: &executorv1.PipelineStreamResponse_StepCompleted{
+				StepCompleted: skipped,
 			},
+		}); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		if err := stream.Send(msg); err != nil {
-			s.logger.Warn("failed to stream pipeline output", zap.Error(err))
-			return
-		}
+// capStepOutput trims a step's captured output to whatever is left of the
+// pipeline-wide output budget and flags the step when anything was cut.
+// used accumulates the bytes retained across steps. The returned limit event
+// is nil unless output was cut.
+func (s *ExecutorServer) capStepOutput(result *executorv1.StepResult, used *int64) *executorv1.LimitEvent {
+	res := result.ExecuteResult
+	if res == nil {
+		return nil
+	}
+
+	size := int64(len(res.Stdout) + len(res.Stderr))
+	limit := s.config.MaxPipelineOutputBytes
+	if limit <= 0 || *used+size <= limit {
+		*used += size
+		return nil
+	}
+
+	remaining := limit - *used
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	res.Stdout = truncateUTF8(res.Stdout, remaining)
+	remaining -= int64(len(res.Stdout))
+	res.Stderr = truncateUTF8(res.Stderr, remaining)
+	*used += int64(len(res.Stdout) + len(res.Stderr))
+	result.OutputTruncated = true
+
+	s.logger.Warn("pipeline output limit reached, truncating step output",
+		zap.String("step_name", result.Name),
+		zap.Int64("max_pipeline_output_bytes", limit),
+	)
+
+	event := &executorv1.LimitEvent{
+		Limit:      executorv1.LimitKind_LIMIT_KIND_OUTPUT_BYTES,
+		Value:      limit,
+		Detail:     "pipeline",
+		OccurredAt: timestamppb.Now(),
+		StepName:   result.Name,
+		StepIndex:  result.StepIndex,
+	}
+	res.LimitsHit = append(res.LimitsHit, event)
+	return event
+}
+
+// addStepTimings copies the timing breakdown of a step's execution onto the
+// step result and adds it to the pipeline totals
+func addStepTimings(response *executorv1.PipelineResponse, result *executorv1.StepResult) {
+	res := result.ExecuteResult
+	if res == nil {
+		return
+	}
+
+	result.QueueWaitMs = res.QueueWaitMs
+	result.StartupMs = res.StartupMs
+	result.RunMs = res.RunMs
+
+	response.QueueWaitMs += res.QueueWaitMs
+	response.StartupMs += res.StartupMs
+	response.RunMs += res.RunMs
+}
+
+// truncateUTF8 shortens s to at most n bytes without splitting a rune,
+// since protobuf strings must remain valid UTF-8
+func truncateUTF8(s string, n int64) string {
+	if int64(len(s)) <= n {
+		return s
+	}
+	if n <= 0 {
+		return ""
+	}
+
+	cut := int(n)
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
 	}
+	return s[:cut]
 }
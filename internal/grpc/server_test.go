@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"github.com/knullci/necrosword/internal/config"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPipelineTimeoutInterruptsLongStep(t *testing.T) {
+	s := newTestServer(t, nil)
+
+	start := time.Now()
+	response, err := s.ExecutePipeline(context.Background(), &executorv1.PipelineRequest{
+		TimeoutSeconds: 1,
+		Steps: []*executorv1.BuildStep{
+			{Name: "long", Tool: "sleep", Args: []string{"30"}},
+			{Name: "next", Tool: "echo", Args: []string{"never"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ExecutePipeline: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("pipeline took %s, the step was not killed at the timeout", elapsed)
+	}
+
+	if response.Success {
+		t.Error("pipeline succeeded after timing out")
+	}
+	if response.StopReason != executorv1.StopReason_STOP_REASON_TIMEOUT {
+		t.Errorf("stop reason = %s, want %s", response.StopReason, executorv1.StopReason_STOP_REASON_TIMEOUT)
+	}
+	if response.FailedStep != "long" {
+		t.Errorf("failed step = %q, want %q", response.FailedStep, "long")
+	}
+	if len(response.StepResults) != 2 {
+		t.Fatalf("got %d step results, want 2", len(response.StepResults))
+	}
+
+	long := response.StepResults[0].ExecuteResult
+	if long.Success || !long.TimedOut || long.Error != "pipeline timed out" {
+		t.Errorf("long step: success=%v timed_out=%v error=%q, want an interrupted step", long.Success, long.TimedOut, long.Error)
+	}
+	if next := response.StepResults[1]; !next.Skipped || next.SkipReason != "pipeline timed out" {
+		t.Errorf("next step: skipped=%v reason=%q, want skipped for the timeout", next.Skipped, next.SkipReason)
+	}
+}
+
+func TestMarkInterrupted(t *testing.T) {
+	ended, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	<-ended.Done()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		result      *executorv1.ExecuteResponse
+		interrupted bool
+	}{
+		{
+			name:   "pipeline still running",
+			ctx:    context.Background(),
+			result: &executorv1.ExecuteResponse{ExitCode: -1, StartedAt: timestamppb.Now()},
+		},
+		{
+			name:   "step succeeded before the timeout",
+			ctx:    ended,
+			result: &executorv1.ExecuteResponse{Success: true, StartedAt: timestamppb.Now()},
+		},
+		{
+			name:   "step failed on its own before the timeout",
+			ctx:    ended,
+			result: &executorv1.ExecuteResponse{ExitCode: 2, Error: "exit status 2", StartedAt: timestamppb.Now()},
+		},
+		{
+			name:        "step killed by the timeout",
+			ctx:         ended,
+			result:      &executorv1.ExecuteResponse{ExitCode: -1, Error: "command timed out", StartedAt: timestamppb.Now()},
+			interrupted: true,
+		},
+		{
+			name:        "step never started",
+			ctx:         ended,
+			result:      &executorv1.ExecuteResponse{Error: "context deadline exceeded"},
+			interrupted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantError := tt.result.Error
+			result := &executorv1.StepResult{Name: "step", ExecuteResult: tt.result}
+
+			if got := markInterrupted(tt.ctx, result); got != tt.interrupted {
+				t.Fatalf("markInterrupted = %v, want %v", got, tt.interrupted)
+			}
+			if tt.interrupted && tt.result.Error != "pipeline timed out" {
+				t.Errorf("error = %q, want %q", tt.result.Error, "pipeline timed out")
+			}
+			if !tt.interrupted && tt.result.Error != wantError {
+				t.Errorf("error changed from %q to %q", wantError, tt.result.Error)
+			}
+		})
+	}
+}
+
+func TestCancelledBatchStartsNoMoreCommands(t *testing.T) {
+	s := newTestServer(t, func(cfg *config.ExecutorConfig) { cfg.MaxConcurrent = 1 })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	req := &executorv1.ExecuteBatchRequest{}
+	for i := 0; i < 3; i++ {
+		req.Requests = append(req.Requests, &executorv1.ExecuteRequest{Tool: "sleep", Args: []string{"30"}})
+	}
+
+	start := time.Now()
+	response, err := s.ExecuteBatch(ctx, req)
+	if err != nil {
+		t.Fatalf("ExecuteBatch: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("batch took %s after its call ended", elapsed)
+	}
+
+	if first := response.Results[0]; first.Result == nil || first.Result.Success {
+		t.Errorf("first command: %v, want it run and killed", first)
+	}
+	for _, result := range response.Results[1:] {
+		if result.Result != nil || result.Error != "batch timed out before the command started" {
+			t.Errorf("command %d: result=%v error=%q, want it never started", result.Index, result.Result, result.Error)
+		}
+	}
+	if response.Failed != 3 {
+		t.Errorf("failed = %d, want 3", response.Failed)
+	}
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"strings"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultShellFlag is used when a shell is given without flags
+const defaultShellFlag = "-c"
+
+// resolveShell turns a shell-mode request into a plain one running the shell
+// interpreter, so the allowlist and the rest of execution see the real
+// tool. Requests without a shell command are returned as they are.
+func (s *ExecutorServer) resolveShell(req *executorv1.ExecuteRequest) (*executorv1.ExecuteRequest, error) {
+	if req.ShellCommand == "" {
+		if req.ShellPath != "" {
+			return nil, invalidField("shell_path", "shell_path requires shell_command")
+		}
+		return req, nil
+	}
+	if req.Tool != "" {
+		return nil, invalidField("tool", "tool must be empty when shell_command is set")
+	}
+
+	shell := strings.Fields(req.ShellPath)
+	if len(shell) == 0 {
+		shell = strings.Fields(s.config.Shell)
+	}
+	if len(shell) == 0 {
+		return nil, fieldError(codes.FailedPrecondition, reasonNotConfigured, "shell_command", "no shell configured")
+	}
+	if len(shell) == 1 {
+		shell = append(shell, defaultShellFlag)
+	}
+
+	resolved := proto.Clone(req).(*executorv1.ExecuteRequest)
+	resolved.Tool = shell[0]
+	resolved.Args = make([]string, 0, len(shell)+len(req.Args))
+	resolved.Args = append(resolved.Args, shell[1:]...)
+	resolved.Args = append(resolved.Args, req.ShellCommand)
+	resolved.Args = append(resolved.Args, req.Args...)
+	resolved.ShellCommand = ""
+	resolved.ShellPath = ""
+	return resolved, nil
+}
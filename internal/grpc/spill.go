@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// spillFile holds captured output beyond the in-memory threshold. The file
+// is created on the first write.
+type spillFile struct {
+	path      string
+	threshold int64
+	f         *os.File
+	err       error
+}
+
+// started reports whether output has been written to the file
+func (sf *spillFile) started() bool {
+	return sf.f != nil
+}
+
+// write appends data to the file, reporting false if it cannot be used
+func (sf *spillFile) write(data string) bool {
+	if sf.err != nil {
+		return false
+	}
+
+	if sf.f == nil {
+		if sf.err = os.MkdirAll(filepath.Dir(sf.path), 0755); sf.err != nil {
+			return false
+		}
+		if sf.f, sf.err = os.OpenFile(sf.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600); sf.err != nil {
+			sf.f = nil
+			return false
+		}
+	}
+
+	if _, err := sf.f.WriteString(data); err != nil {
+		sf.err = err
+		return false
+	}
+	return true
+}
+
+// close closes the file and returns its path, or "" if nothing was spilled
+func (sf *spillFile) close() string {
+	if sf == nil || sf.f == nil {
+		return ""
+	}
+	if err := sf.f.Close(); err != nil && sf.err == nil {
+		sf.err = err
+	}
+	return sf.path
+}
+
+// spillDir returns the directory spill files are written to
+func (s *ExecutorServer) spillDir() string {
+	if s.config.SpillToDisk.Dir != "" {
+		return s.config.SpillToDisk.Dir
+	}
+	return filepath.Join(s.config.WorkspaceBase, ".spill")
+}
+
+// newSpillFile returns the spill file for one output stream of an
+// execution, or nil when spilling is disabled
+func (s *ExecutorServer) newSpillFile(processID, stream string) *spillFile {
+	if !s.config.SpillToDisk.Enabled {
+		return nil
+	}
+	return &spillFile{
+		path:      filepath.Join(s.spillDir(), processID+"."+stream),
+		threshold: s.config.SpillToDisk.ThresholdBytes,
+	}
+}
+
+// closeSpill closes a capture's spill file, logging any failure to use it,
+// and returns the file path if output was spilled
+func (s *ExecutorServer) closeSpill(c *capture) string {
+	path := c.spill.close()
+	if c.spill != nil && c.spill.err != nil {
+		s.logger.Warn("failed to spill output to disk, kept in memory instead",
+			zap.String("path", c.spill.path),
+			zap.Error(c.spill.err),
+		)
+	}
+	return path
+}
+
+// removeSpillFiles removes spill files that are no longer needed
+func removeSpillFiles(paths ...string) {
+	for _, path := range paths {
+		if path != "" {
+			os.Remove(path)
+		}
+	}
+}
+
+// expireSpillFiles removes spill files older than the retention period
+// until the server is closed
+func (s *ExecutorServer) expireSpillFiles() {
+	retention := time.Duration(s.config.SpillToDisk.Retention) * time.Second
+	ticker := time.NewTicker(min(retention, time.Minute))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+
+		entries, err := os.ReadDir(s.spillDir())
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			info, err := entry.Info()
+			if err != nil || entry.IsDir() || time.Since(info.ModTime()) < retention {
+				continue
+			}
+			if !strings.HasSuffix(entry.Name(), ".stdout") && !strings.HasSuffix(entry.Name(), ".stderr") {
+				continue
+			}
+			path := filepath.Join(s.spillDir(), entry.Name())
+			if err := os.Remove(path); err == nil {
+				s.logger.Debug("removed expired spill file", zap.String("path", path))
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// startCommand starts cmd, retrying up to executor.start_retries times with
+// a doubling backoff while the start fails for lack of resources, e.g. under
+// fork pressure. A started command is never retried. Each retry starts a
+// fresh copy of cmd, which is returned with the number of attempts.
+func (s *ExecutorServer) startCommand(ctx context.Context, cmd *exec.Cmd, proc *RunningProcess) (*exec.Cmd, int32, error) {
+	backoff := time.Duration(s.config.StartRetryBackoffMs) * time.Millisecond
+
+	var attempts int32
+	for {
+		attempts++
+		err := cmd.Start()
+		if err == nil || int(attempts) > s.config.StartRetries || !transientStartError(err) {
+			return cmd, attempts, err
+		}
+
+		s.logger.Warn("failed to start command, retrying",
+			zap.String("process_id", proc.ID),
+			zap.String("tool", proc.Tool),
+			zap.Int32("attempt", attempts+1),
+			zap.Duration("backoff", backoff),
+			zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return cmd, attempts, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+
+		retry := s.command(ctx, proc, cmd.Path, cmd.Args[1:])
+		retry.Args = cmd.Args
+		retry.Dir = cmd.Dir
+		retry.Env = cmd.Env
+		retry.Stdin = cmd.Stdin
+		retry.Stdout = cmd.Stdout
+		retry.Stderr = cmd.Stderr
+		retry.ExtraFiles = cmd.ExtraFiles
+		retry.SysProcAttr = cmd.SysProcAttr
+		cmd = retry
+	}
+}
+
+// transientStartError reports whether a start failed for lack of resources
+// rather than because the command cannot run
+func transientStartError(err error) bool {
+	return errors.Is(err, syscall.EAGAIN) ||
+		errors.Is(err, syscall.ENOMEM) ||
+		errors.Is(err, syscall.ETXTBSY)
+}
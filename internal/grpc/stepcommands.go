@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Phases of a pipeline step's commands, as reported in StepOutputEvent
+const (
+	phaseBefore = "before"
+	phaseAfter  = "after"
+)
+
+// stepObservers returns the observer for one phase of a step, "" being the
+// step's own command. A nil stepObservers observes nothing.
+type stepObservers func(phase string) *observer
+
+// phase returns the observer for phase
+func (f stepObservers) phase(phase string) *observer {
+	if f == nil {
+		return nil
+	}
+	return f(phase)
+}
+
+// stepCommandRequests builds and validates the requests running a step's
+// before or after commands, based on the step's own request
+func (s *ExecutorServer) stepCommandRequests(
+	ctx context.Context,
+	stepReq *executorv1.ExecuteRequest,
+	workspace string,
+	step *executorv1.BuildStep,
+	commands []*executorv1.StepCommand,
+	field string,
+) ([]*executorv1.ExecuteRequest, error) {
+	requests := make([]*executorv1.ExecuteRequest, 0, len(commands))
+	for i, command := range commands {
+		req := proto.Clone(stepReq).(*executorv1.ExecuteRequest)
+		req.Tool = command.Tool
+		req.Args = command.Args
+		req.ShellCommand = command.ShellCommand
+		req.ShellPath = step.ShellPath
+		req.ProgressPattern = ""
+		req.TailLines = 0
+		req.ExpectedExitCodes = nil
+		req.SuccessFile = ""
+		if command.TimeoutSeconds > 0 {
+			req.TimeoutSeconds = command.TimeoutSeconds
+		}
+
+		req, err := s.resolveShell(req)
+		if err != nil {
+			return nil, err
+		}
+		if req.Tool == "" {
+			return nil, invalidField(fmt.Sprintf("%s[%d].tool", field, i), "tool or shell_command is required")
+		}
+		if err := s.validateTool(ctx, req); err != nil {
+			return nil, err
+		}
+		if step.Image != "" {
+			if req, err = s.containerize(req, workspace, step.Image); err != nil {
+				return nil, err
+			}
+		}
+		requests = append(requests, req)
+	}
+	return requests, nil
+}
+
+// runStepCommands runs a step's before or after commands in order, adding
+// their responses to results. It stops at the first failing command and
+// returns its response, or nil if all succeeded.
+func (s *ExecutorServer) runStepCommands(
+	ctx context.Context,
+	requests []*executorv1.ExecuteRequest,
+	scope *pipelineScope,
+	obs *observer,
+	results *[]*executorv1.ExecuteResponse,
+) (*executorv1.ExecuteResponse, error) {
+	for _, req := range requests {
+		response, err := s.run(ctx, req, scope, obs)
+		if err != nil {
+			return nil, err
+		}
+		*results = append(*results, response)
+		if !response.Success {
+			return response, nil
+		}
+	}
+	return nil, nil
+}
+
+// stepCommandFailure describes a failed before or after command as the
+// failure of the step
+func stepCommandFailure(phase string, failed *executorv1.ExecuteResponse) string {
+	if failed.Error != "" {
+		return fmt.Sprintf("%s command failed: %s", phase, failed.Error)
+	}
+	return fmt.Sprintf("%s command exited with %d", phase, failed.ExitCode)
+}
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"regexp"
+	"sync"
+)
+
+// compileStopPattern compiles a request's stop_on_pattern, nil if unset
+func compileStopPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, invalidField("stop_on_pattern", "invalid stop_on_pattern: %v", err)
+	}
+	return re, nil
+}
+
+// withStopPattern wraps obs so stop is called once, after the first output
+// line matching pattern has been passed on
+func withStopPattern(obs *observer, pattern *regexp.Regexp, stop func()) *observer {
+	var once sync.Once
+	wrapped := *obs
+	wrapped.line = func(line string, isStdout bool) {
+		if obs.line != nil {
+			obs.line(line, isStdout)
+		}
+		if pattern.MatchString(line) {
+			once.Do(stop)
+		}
+	}
+	return &wrapped
+}
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// sweep runs sweepRunning, expires workspace leases and drops expired
+// detached results every interval until the server is closed
+func (s *ExecutorServer) sweep(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.sweepRunning(time.Now())
+			s.expireWorkspaceLeases()
+			s.pruneResults()
+		}
+	}
+}
+
+// sweepRunning removes tracked processes that would otherwise inflate the
+// running count forever: those that no longer exist or are zombies without
+// having been waited for, found so on sweeps a sweep interval apart, and
+// those waited for whose runner did not finish within a sweep interval plus
+// the post-exec hook's timeout
+func (s *ExecutorServer) sweepRunning(now time.Time) {
+	interval := time.Duration(s.config.SweepInterval) * time.Second
+	finishing := interval
+	if s.config.PostExecHook.Tool != "" {
+		finishing += time.Duration(s.config.PostExecHook.Timeout) * time.Second
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, proc := range s.running {
+		if proc.Command == nil || proc.Command.Process == nil {
+			continue
+		}
+
+		pid := proc.Command.Process.Pid
+		if proc.exited.Load() {
+			// The runner still finishes up, e.g. runs the post-exec hook
+			if now.Sub(time.Unix(0, proc.exitedAt.Load())) <= finishing {
+				continue
+			}
+		} else if processAlive(pid) {
+			proc.goneSince = time.Time{}
+			continue
+		} else if proc.goneSince.IsZero() {
+			// It may be waited for right now
+			proc.goneSince = now
+			continue
+		} else if now.Sub(proc.goneSince) < interval {
+			continue
+		}
+
+		delete(s.running, id)
+		s.logger.Warn("reaped stale running process entry",
+			zap.String("process_id", id),
+			zap.String("tool", proc.Tool),
+			zap.Int("pid", pid),
+			zap.Time("started_at", proc.StartedAt),
+		)
+	}
+}
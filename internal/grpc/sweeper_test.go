@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"github.com/knullci/necrosword/internal/config"
+)
+
+// trackedProcess returns the entry s tracks for id, nil if none
+func trackedProcess(s *ExecutorServer, id string) *RunningProcess {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.running[id]
+}
+
+func TestSweepKeepsProcessInPostExecHook(t *testing.T) {
+	s := newTestServer(t, func(cfg *config.ExecutorConfig) {
+		cfg.SweepInterval = 60
+		cfg.PostExecHook = config.HookConfig{Tool: "sleep", Args: []string{"1"}, Timeout: 30}
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Execute(context.Background(), &executorv1.ExecuteRequest{Tool: "echo", Args: []string{"hi"}})
+	}()
+
+	// The process was waited for and the hook runs
+	var proc *RunningProcess
+	waitFor(t, func() bool {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		for _, p := range s.running {
+			if p.exited.Load() {
+				proc = p
+				return true
+			}
+		}
+		return false
+	})
+
+	s.sweepRunning(time.Now().Add(61 * time.Second))
+	if trackedProcess(s, proc.ID) == nil {
+		t.Fatal("sweep reaped a process whose post-exec hook is running")
+	}
+
+	// A runner stuck past the hook's timeout is reaped
+	s.sweepRunning(time.Now().Add(91 * time.Second))
+	if trackedProcess(s, proc.ID) != nil {
+		t.Error("sweep kept a process stuck past its post-exec hook timeout")
+	}
+	<-done
+}
+
+func TestSweepReapsProcessGoneUnwaited(t *testing.T) {
+	s := newTestServer(t, func(cfg *config.ExecutorConfig) { cfg.SweepInterval = 60 })
+
+	cmd := exec.Command("true")
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	s.mu.Lock()
+	s.running["gone"] = &RunningProcess{ID: "gone", Tool: "true", Command: cmd, waited: make(chan struct{})}
+	s.mu.Unlock()
+
+	now := time.Now()
+	s.sweepRunning(now)
+	if trackedProcess(s, "gone") == nil {
+		t.Fatal("first sweep reaped a process that may be waited for right now")
+	}
+	s.sweepRunning(now.Add(60 * time.Second))
+	if trackedProcess(s, "gone") != nil {
+		t.Error("sweep kept a process gone for a sweep interval")
+	}
+}
+
+func TestSweepReapsZombie(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("zombies are detected through /proc")
+	}
+	s := newTestServer(t, func(cfg *config.ExecutorConfig) { cfg.SweepInterval = 60 })
+
+	cmd := exec.Command("true")
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	defer cmd.Wait()
+	waitFor(t, func() bool { return isZombie(cmd.Process.Pid) })
+
+	s.mu.Lock()
+	s.running["zombie"] = &RunningProcess{ID: "zombie", Tool: "true", Command: cmd, waited: make(chan struct{})}
+	s.mu.Unlock()
+
+	now := time.Now()
+	s.sweepRunning(now)
+	s.sweepRunning(now.Add(60 * time.Second))
+	if trackedProcess(s, "zombie") != nil {
+		t.Error("sweep kept a zombie process")
+	}
+}
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Built-in template variables, referenced in args and env as ${NAME}
+const (
+	templateHost       = "NECRO_HOST"
+	templateProcessID  = "NECRO_PROCESS_ID"
+	templateTimestamp  = "NECRO_TIMESTAMP"
+	templatePipelineID = "NECRO_PIPELINE_ID"
+	templateStepName   = "NECRO_STEP_NAME"
+	templateStepIndex  = "NECRO_STEP_INDEX"
+	templateGitSHA     = "NECRO_GIT_SHA"
+)
+
+// templateName matches valid template variable names
+var templateName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// templatePattern matches ${NAME} references. Bare $NAME is left alone so
+// shell-style arguments pass through untouched.
+var templatePattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// templateVars returns the variables available to an execution: the static
+// executor.template_vars, then values captured by earlier pipeline steps,
+// overridden by the built-in NECRO_* values
+func (s *ExecutorServer) templateVars(processID string, scope *pipelineScope) map[string]string {
+	vars := make(map[string]string, len(s.config.TemplateVars)+7)
+	for name, value := range s.config.TemplateVars {
+		// viper lower-cases map keys, template names are upper-case
+		vars[strings.ToUpper(name)] = value
+	}
+	if scope != nil {
+		for name, value := range scope.outputs {
+			vars[name] = value
+		}
+	}
+
+	vars[templateHost] = s.hostname
+	vars[templateProcessID] = processID
+	vars[templateTimestamp] = time.Now().UTC().Format(time.RFC3339)
+
+	if scope != nil {
+		vars[templatePipelineID] = scope.pipelineID
+		vars[templateStepName] = scope.stepName
+		vars[templateStepIndex] = strconv.Itoa(scope.stepIndex)
+		vars[templateGitSHA] = scope.gitSHA
+	}
+
+	return vars
+}
+
+// expandTemplates replaces ${NAME} references to known variables in each
+// value. Unknown references are kept verbatim.
+func expandTemplates(values []string, vars map[string]string) []string {
+	if len(values) == 0 {
+		return values
+	}
+
+	expanded := make([]string, len(values))
+	for i, value := range values {
+		expanded[i] = templatePattern.ReplaceAllStringFunc(value, func(ref string) string {
+			if v, ok := vars[ref[2:len(ref)-1]]; ok {
+				return v
+			}
+			return ref
+		})
+	}
+	return expanded
+}
+
+// lastLine returns the last non-blank line of output, trimmed
+func lastLine(output string) string {
+	lines := strings.Split(output, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if line := strings.TrimSpace(lines[i]); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,197 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"path"
+	"path/filepath"
+
+	"github.com/knullci/necrosword/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// tenantKey is the context key of the calling tenant's ID
+type tenantKey struct{}
+
+// tenantFromContext returns the tenant stored by TenantAuth, if any
+func tenantFromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	return tenant, ok
+}
+
+// callerTenant returns the tenant of the caller, "" without tenant
+// isolation. Processes, pipelines, results and workspaces record it as
+// their owner and are only visible to callers of the same tenant.
+func callerTenant(ctx context.Context) string {
+	tenant, _ := tenantFromContext(ctx)
+	return tenant
+}
+
+// tenantExemptMethods may be called without tenant credentials, e.g. by
+// load balancer health checks
+var tenantExemptMethods = map[string]bool{"Health": true, "Ping": true}
+
+// TenantAuth authenticates the caller's tenant into the request context,
+// where executions check their directories against it
+type TenantAuth struct {
+	source string
+	tokens []config.TenantToken
+}
+
+// NewTenantAuth authenticates tenants as configured by cfg
+func NewTenantAuth(cfg config.TenantIsolationConfig) *TenantAuth {
+	return &TenantAuth{source: cfg.Source, tokens: cfg.Tokens}
+}
+
+// withTenant returns ctx carrying the tenant the caller authenticated as.
+// Calls without valid credentials are rejected unless their method is
+// exempt.
+func (t *TenantAuth) withTenant(ctx context.Context, fullMethod string) (context.Context, error) {
+	tenant, ok := t.authenticate(ctx)
+	if !ok {
+		if tenantExemptMethods[path.Base(fullMethod)] {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "tenant credentials are required")
+	}
+	return context.WithValue(ctx, tenantKey{}, tenant), nil
+}
+
+// authenticate returns the tenant of the caller's credentials
+func (t *TenantAuth) authenticate(ctx context.Context) (string, bool) {
+	if t.source == "certificate" {
+		p, ok := peer.FromContext(ctx)
+		if !ok {
+			return "", false
+		}
+		info, ok := p.AuthInfo.(credentials.TLSInfo)
+		if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+			return "", false
+		}
+		tenant := info.State.VerifiedChains[0][0].Subject.CommonName
+		return tenant, config.TenantPattern.MatchString(tenant)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) != 1 {
+		return "", false
+	}
+	// Every token is compared so timing does not reveal which one matched
+	tenant := ""
+	for _, token := range t.tokens {
+		if subtle.ConstantTimeCompare([]byte(values[0]), []byte("Bearer "+token.Token)) == 1 {
+			tenant = token.Tenant
+		}
+	}
+	return tenant, tenant != ""
+}
+
+// UnaryInterceptor stores the tenant of unary calls
+func (t *TenantAuth) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := t.withTenant(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor stores the tenant of streaming calls
+func (t *TenantAuth) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := t.withTenant(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &tenantStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// tenantStream overrides a stream's context with one carrying the tenant
+type tenantStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context implements grpc.ServerStream
+func (s *tenantStream) Context() context.Context {
+	return s.ctx
+}
+
+// checkTenantDir rejects a directory outside WorkspaceBase/<tenant> when
+// tenant isolation is enabled. Symlinks are resolved as far as the path
+// exists, so a link cannot lead out of the tenant's directory.
+func (s *ExecutorServer) checkTenantDir(ctx context.Context, field, dir string) error {
+	if !s.config.TenantIsolation.Enabled {
+		return nil
+	}
+
+	tenant, ok := tenantFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "tenant credentials are required")
+	}
+	if dir == "" {
+		return fieldError(codes.PermissionDenied, reasonOtherTenant, field, "%s is required with tenant isolation", field)
+	}
+
+	root, err := resolvePath(filepath.Join(s.config.WorkspaceBase, tenant))
+	if err != nil {
+		return fieldError(codes.FailedPrecondition, reasonWorkDir, field, "failed to resolve workspace of tenant %s: %v", tenant, err)
+	}
+	path, err := resolvePath(dir)
+	if err != nil {
+		return fieldError(codes.FailedPrecondition, reasonWorkDir, field, "failed to resolve %s: %v", field, err)
+	}
+
+	if rel, err := filepath.Rel(root, path); err != nil || (rel != "." && !filepath.IsLocal(rel)) {
+		return fieldError(codes.PermissionDenied, reasonOtherTenant, field, "%s %s is outside the workspace of tenant %s", field, dir, tenant)
+	}
+	return nil
+}
+
+// checkPipelineOwner rejects a pipeline ID that a pipeline or replay log of
+// another tenant already uses, which would otherwise be taken over
+func (s *ExecutorServer) checkPipelineOwner(ctx context.Context, pipelineID string) error {
+	tenant := callerTenant(ctx)
+
+	s.mu.RLock()
+	pipeline, running := s.pipelines[pipelineID]
+	s.mu.RUnlock()
+	s.replaysMu.Lock()
+	log, recorded := s.replays[pipelineID]
+	s.replaysMu.Unlock()
+
+	if (running && pipeline.Tenant != tenant) || (recorded && log.tenant != tenant) {
+		return fieldError(codes.AlreadyExists, reasonOtherTenant, "id", "pipeline id %s is in use", pipelineID)
+	}
+	return nil
+}
+
+// resolvePath returns the absolute form of path with symlinks evaluated in
+// its longest existing prefix
+func resolvePath(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	var rest []string
+	for {
+		if resolved, err := filepath.EvalSymlinks(path); err == nil {
+			return filepath.Join(append([]string{resolved}, rest...)...), nil
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			return filepath.Join(append([]string{path}, rest...)...), nil
+		}
+		rest = append([]string{filepath.Base(path)}, rest...)
+		path = parent
+	}
+}
@@ -0,0 +1,318 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Defaults of a terminal session's window
+const (
+	defaultTerminalRows = 24
+	defaultTerminalCols = 80
+	defaultTerm         = "xterm-256color"
+)
+
+// terminalReadBytes is the most terminal output sent in one message
+const terminalReadBytes = 32 * 1024
+
+// terminalDrainTimeout bounds how long output is still read after the
+// command exited, as a background process may keep the terminal open
+const terminalDrainTimeout = time.Second
+
+// ExecuteTerminal runs a command on a pseudo-terminal. The client's first
+// message starts it; later ones are typed into the terminal or resize it,
+// while its output is streamed back until it exits. The command is hung up
+// on when the client closes its side of the stream or disconnects.
+func (s *ExecutorServer) ExecuteTerminal(stream executorv1.ExecutorService_ExecuteTerminalServer) error {
+	if !s.config.Terminal.Enabled {
+		return status.Error(codes.FailedPrecondition, "terminal sessions are disabled")
+	}
+	if !ptySupported {
+		return status.Error(codes.Unimplemented, "terminal sessions are not supported on this platform")
+	}
+
+	release, err := s.acquireStream()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := first.GetStart()
+	if start == nil || start.Request == nil {
+		return invalidField("start", "the first message must start the session")
+	}
+
+	req, err := s.resolveShell(start.Request)
+	if err != nil {
+		return err
+	}
+	req, releaseWorkspace, err := s.resolveWorkspace(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer releaseWorkspace()
+
+	if err := s.validateTool(ctx, req); err != nil {
+		return err
+	}
+	if err := s.checkTenantDir(ctx, "work_dir", req.WorkDir); err != nil {
+		return err
+	}
+	if err := s.checkFence(ctx, req.JobKey, req.FenceToken); err != nil {
+		return err
+	}
+
+	createdWorkDir, err := s.prepareWorkDir(req)
+	if err != nil {
+		return err
+	}
+
+	succeeded := false
+	if createdWorkDir {
+		defer func() {
+			s.cleanupWorkDir(req.WorkDir, req.CleanupWorkDir, succeeded)
+		}()
+	}
+
+	exited, err := s.runTerminal(stream, req, start)
+	if err != nil {
+		return err
+	}
+	succeeded = exited.Success
+	return nil
+}
+
+// runTerminal runs req on a pseudo-terminal, relaying the stream's input to
+// it and its output to the stream, and returns how it exited, which has
+// been sent. An error is only returned when the command could not be
+// started.
+func (s *ExecutorServer) runTerminal(stream executorv1.ExecutorService_ExecuteTerminalServer, req *executorv1.ExecuteRequest, start *executorv1.TerminalStart) (*executorv1.TerminalExited, error) {
+	ctx := stream.Context()
+	if err := checkWorkDir(req.WorkDir); err != nil {
+		return nil, err
+	}
+	if err := s.checkRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	release, err := s.acquireSlot(ctx, req.Tool)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	timeout := time.Duration(s.config.DefaultTimeout) * time.Second
+	if req.TimeoutSeconds > 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	processID := s.ids.newID()
+	runningProc := &RunningProcess{
+		ID:      processID,
+		Tool:    req.Tool,
+		Cancel:  cancel,
+		Tenant:  callerTenant(ctx),
+		logArgs: s.shouldLogArgs(req.Tool, req.LogArgs),
+		waited:  make(chan struct{}),
+	}
+
+	vars := s.templateVars(processID, nil)
+	var secrets []string
+	args, err := s.resolveSecrets(ctx, "args", s.withDefaultArgs(req.Tool, req.Args), vars, &secrets)
+	if err != nil {
+		return nil, err
+	}
+	env, err := s.resolveSecrets(ctx, "env", req.Env, vars, &secrets)
+	if err != nil {
+		return nil, err
+	}
+	mask := newMasker(secrets)
+	runningProc.Args = mask.maskAll(args)
+
+	cmd := s.command(ctx, runningProc, req.Tool, args)
+	if req.WorkDir != "" {
+		cmd.Dir = req.WorkDir
+	}
+
+	var homeEnv []string
+	if req.IsolateHome {
+		env, remove, err := s.isolatedHome(processID)
+		if err != nil {
+			return nil, err
+		}
+		defer remove()
+		homeEnv = env
+	}
+
+	term := start.Term
+	if term == "" {
+		term = defaultTerm
+	}
+	cmd.Env = mergeEnv(s.environ(cmd), []string{"TERM=" + term}, homeEnv, s.contextEnv(processID, nil), env)
+	if err := s.isolate(cmd); err != nil {
+		return nil, err
+	}
+
+	ptm, tty, err := openPTY()
+	if err != nil {
+		return nil, err
+	}
+	defer closeFiles(ptm)
+
+	rows, cols := uint16(defaultTerminalRows), uint16(defaultTerminalCols)
+	if size := start.Size; size != nil && size.Rows > 0 && size.Cols > 0 {
+		rows, cols = uint16(size.Rows), uint16(size.Cols)
+	}
+	if err := setPTYSize(ptm, rows, cols); err != nil {
+		closeFiles(tty)
+		return nil, fmt.Errorf("failed to set terminal size: %w", err)
+	}
+
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = tty, tty, tty
+	setControllingTerminal(cmd)
+
+	startTime := time.Now()
+	cmd, _, err = s.startCommand(ctx, cmd, runningProc)
+
+	// Output only ends once every holder of the terminal closed it
+	closeFiles(tty)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to start command: %w", err)
+	}
+	runningProc.StartedAt = startTime
+
+	s.mu.Lock()
+	s.running[processID] = runningProc
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.running, processID)
+		s.mu.Unlock()
+	}()
+
+	s.logger.Info("terminal session started",
+		zap.String("process_id", processID),
+		zap.String("tool", req.Tool),
+		zap.Int("pid", cmd.Process.Pid),
+	)
+
+	sender := &streamSender[*executorv1.TerminalOutput]{send: stream.Send, logger: s.logger}
+	sender.Send(&executorv1.TerminalOutput{
+		Output: &executorv1.TerminalOutput_Started{Started: &executorv1.TerminalStarted{
+			ProcessId: processID,
+			OsPid:     int32(cmd.Process.Pid),
+		}},
+	})
+
+	var lifetimeExceeded atomic.Bool
+	lifetime := s.maxLifetime(req)
+	if lifetime > 0 {
+		timer := time.AfterFunc(lifetime, func() {
+			lifetimeExceeded.Store(true)
+			runningProc.Terminate(s.cancelSignal)
+		})
+		defer timer.Stop()
+	}
+
+	// Input is relayed until the client is gone, which hangs up on the
+	// command. Recv fails once this call returned.
+	go func() {
+		for {
+			in, err := stream.Recv()
+			if err != nil {
+				if !runningProc.exited.Load() {
+					runningProc.Terminate(syscall.SIGHUP)
+				}
+				return
+			}
+
+			switch input := in.Input.(type) {
+			case *executorv1.TerminalInput_Data:
+				ptm.Write(input.Data)
+			case *executorv1.TerminalInput_Resize:
+				if input.Resize.Rows > 0 && input.Resize.Cols > 0 {
+					setPTYSize(ptm, uint16(input.Resize.Rows), uint16(input.Resize.Cols))
+				}
+			case *executorv1.TerminalInput_Start:
+				s.logger.Warn("ignoring start of a running terminal session", zap.String("process_id", processID))
+			}
+		}
+	}()
+
+	// Reads fail with EIO once the terminal is no longer open anywhere
+	outputDone := make(chan struct{})
+	go func() {
+		defer close(outputDone)
+		buf := make([]byte, terminalReadBytes)
+		for {
+			n, err := ptm.Read(buf)
+			if n > 0 {
+				data := []byte(mask.mask(string(buf[:n])))
+				sender.Send(&executorv1.TerminalOutput{
+					Output: &executorv1.TerminalOutput_Data{Data: data},
+				})
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	err = cmd.Wait()
+	runningProc.markExited()
+	duration := time.Since(startTime)
+
+	select {
+	case <-outputDone:
+	case <-time.After(terminalDrainTimeout):
+		ptm.Close()
+		<-outputDone
+	}
+
+	exited := &executorv1.TerminalExited{Success: err == nil, DurationMs: duration.Milliseconds()}
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			exited.ExitCode = -1
+			exited.Error = "command timed out"
+			exited.TimedOut = true
+		} else if lifetimeExceeded.Load() {
+			exited.ExitCode = -1
+			exited.Error = fmt.Sprintf("process exceeded its maximum lifetime of %s", lifetime)
+		} else if exitErr, ok := err.(*exec.ExitError); ok {
+			exited.ExitCode = int32(exitErr.ExitCode())
+			exited.Error = exitErr.Error()
+		} else {
+			exited.ExitCode = -1
+			exited.Error = err.Error()
+		}
+	}
+	sender.SendAndStop(&executorv1.TerminalOutput{
+		Output: &executorv1.TerminalOutput_Exited{Exited: exited},
+	})
+
+	s.logger.Info("terminal session ended",
+		zap.String("process_id", processID),
+		zap.String("tool", req.Tool),
+		zap.Int32("exit_code", exited.ExitCode),
+		zap.Duration("duration", duration),
+	)
+	return exited, nil
+}
@@ -0,0 +1,227 @@
+package grpc
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// Bounds on what is read from a test report
+const (
+	maxTestReportBytes    = 64 * 1024 * 1024
+	maxTestFailures       = 100
+	maxTestFailureMessage = 4096
+)
+
+// validateTestReport checks a step's test_report declaration
+func validateTestReport(step *executorv1.BuildStep) error {
+	spec := step.TestReport
+	if spec == nil {
+		return nil
+	}
+	if spec.Format == executorv1.TestReportFormat_TEST_REPORT_FORMAT_UNSPECIFIED {
+		return invalidField("test_report.format", "test_report.format is required")
+	}
+	if !filepath.IsLocal(spec.Path) {
+		return invalidField("test_report.path", "test_report.path must be a relative path inside the working directory")
+	}
+	return nil
+}
+
+// parseTestReport reads the test report of spec from dir. Problems reading
+// or parsing it are reported in the report's error.
+func parseTestReport(spec *executorv1.TestReportSpec, dir string) *executorv1.TestReport {
+	report := &executorv1.TestReport{}
+
+	f, err := os.Open(filepath.Join(dir, spec.Path))
+	if err != nil {
+		report.Error = fmt.Sprintf("failed to read test report: %v", err)
+		return report
+	}
+	defer f.Close()
+	r := io.LimitReader(f, maxTestReportBytes)
+
+	switch spec.Format {
+	case executorv1.TestReportFormat_TEST_REPORT_FORMAT_JUNIT:
+		err = parseJUnit(r, report)
+	case executorv1.TestReportFormat_TEST_REPORT_FORMAT_GO_JSON:
+		err = parseGoTestJSON(r, report)
+	default:
+		err = fmt.Errorf("unknown format %s", spec.Format)
+	}
+	if err != nil {
+		report.Error = fmt.Sprintf("failed to parse test report: %v", err)
+	}
+	return report
+}
+
+// addTestResult counts a test, recording a failure's message
+func addTestResult(report *executorv1.TestReport, suite, name string, failed, skipped bool, message string) {
+	report.Total++
+	switch {
+	case failed:
+		report.Failed++
+		if len(report.Failures) == maxTestFailures {
+			report.FailuresTruncated = true
+			return
+		}
+		if len(message) > maxTestFailureMessage {
+			message = strings.ToValidUTF8(message[:maxTestFailureMessage], "")
+		}
+		report.Failures = append(report.Failures, &executorv1.TestFailure{Suite: suite, Name: name, Message: message})
+	case skipped:
+		report.Skipped++
+	default:
+		report.Passed++
+	}
+}
+
+// junitCase is a <testcase> element
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Failure   *junitOutcome `xml:"failure"`
+	Error     *junitOutcome `xml:"error"`
+	Skipped   *junitOutcome `xml:"skipped"`
+}
+
+// junitOutcome is a <failure>, <error> or <skipped> element
+type junitOutcome struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// parseJUnit counts the test cases of a JUnit XML report, in any
+// <testsuite> nesting. The report is decoded as it is read, so the cases
+// before a syntax error are counted.
+func parseJUnit(r io.Reader, report *executorv1.TestReport) error {
+	decoder := xml.NewDecoder(r)
+	var suites []string
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch el := token.(type) {
+		case xml.StartElement:
+			switch el.Name.Local {
+			case "testsuite":
+				suites = append(suites, junitAttr(el, "name"))
+			case "testcase":
+				var c junitCase
+				if err := decoder.DecodeElement(&c, &el); err != nil {
+					return err
+				}
+				addJUnitCase(report, suites, c)
+			}
+		case xml.EndElement:
+			if el.Name.Local == "testsuite" && len(suites) > 0 {
+				suites = suites[:len(suites)-1]
+			}
+		}
+	}
+}
+
+// junitAttr returns the value of an element's attribute, "" if it has none
+func junitAttr(el xml.StartElement, name string) string {
+	for _, attr := range el.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// addJUnitCase counts a test case of the innermost of suites
+func addJUnitCase(report *executorv1.TestReport, suites []string, c junitCase) {
+	outcome := c.Failure
+	if outcome == nil {
+		outcome = c.Error
+	}
+
+	suite := c.Classname
+	if suite == "" && len(suites) > 0 {
+		suite = suites[len(suites)-1]
+	}
+	var message string
+	if outcome != nil {
+		message = strings.TrimSpace(outcome.Message + "\n" + strings.TrimSpace(outcome.Text))
+	}
+	addTestResult(report, suite, c.Name, outcome != nil, c.Skipped != nil, message)
+}
+
+// goTestEvent is a line of go test -json output
+type goTestEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Output  string
+}
+
+// parseGoTestJSON counts the test results of go test -json output. Lines
+// that are not JSON, such as build output, are skipped.
+func parseGoTestJSON(r io.Reader, report *executorv1.TestReport) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	output := make(map[string]*strings.Builder)
+	failedTests := make(map[string]bool)
+	events := 0
+	for scanner.Scan() {
+		var event goTestEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil || event.Action == "" {
+			continue
+		}
+		events++
+
+		key := event.Package + " " + event.Test
+		switch event.Action {
+		case "output":
+			out, ok := output[key]
+			if !ok {
+				out = &strings.Builder{}
+				output[key] = out
+			}
+			if out.Len() < maxTestFailureMessage {
+				out.WriteString(event.Output)
+			}
+		case "pass", "fail", "skip":
+			failed := event.Action == "fail"
+			if event.Test != "" {
+				if failed {
+					failedTests[event.Package] = true
+				}
+				addTestResult(report, event.Package, event.Test, failed, event.Action == "skip", testOutput(output[key]))
+			} else if failed && !failedTests[event.Package] {
+				addTestResult(report, event.Package, "", true, false, testOutput(output[key]))
+			}
+			delete(output, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if events == 0 {
+		return fmt.Errorf("no go test -json events found")
+	}
+	return nil
+}
+
+// testOutput returns the output collected for a test, "" if none was
+func testOutput(out *strings.Builder) string {
+	if out == nil {
+		return ""
+	}
+	return strings.TrimSpace(out.String())
+}
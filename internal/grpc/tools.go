@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"github.com/knullci/necrosword/internal/config"
+	"go.uber.org/zap"
+)
+
+// ListTools returns the allowed tools and the policies applied to them.
+// Version check commands and their output are not exposed.
+func (s *ExecutorServer) ListTools(ctx context.Context, req *executorv1.ListToolsRequest) (*executorv1.ListToolsResponse, error) {
+	entries := s.tools.Load().Entries()
+	tools := make([]*executorv1.ToolInfo, 0, len(entries))
+
+	for _, entry := range entries {
+		info := &executorv1.ToolInfo{
+			Name:                  entry,
+			Pattern:               config.IsToolPattern(entry),
+			DefaultTimeoutSeconds: int32(s.config.DefaultTimeout),
+			ArgsLogged:            s.shouldLogArgs(entry, nil),
+		}
+
+		if !info.Pattern {
+			if path, err := exec.LookPath(entry); err == nil {
+				info.ResolvedPath = path
+			}
+
+			if pin, ok := s.config.ToolVersions[strings.ToLower(entry)]; ok {
+				info.VersionPattern = pin.Pattern
+				version, err := s.toolVersion(ctx, entry)
+				info.Version = version
+				if err != nil {
+					info.VersionError = err.Error()
+				}
+			}
+		}
+
+		tools = append(tools, info)
+	}
+
+	return &executorv1.ListToolsResponse{Tools: tools}, nil
+}
+
+// ReloadTools re-reads executor.allowed_tools_file and swaps in the new
+// allowlist. The current list is kept if the file cannot be read or has an
+// invalid entry.
+func (s *ExecutorServer) ReloadTools() error {
+	file := s.config.AllowedToolsFile
+	if file == "" {
+		return fmt.Errorf("executor.allowed_tools_file is not set")
+	}
+
+	entries, err := config.ReadAllowedToolsFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	tools, err := config.NewToolMatcher(entries)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", file, err)
+	}
+
+	s.tools.Store(tools)
+	s.logger.Info("reloaded allowed tools",
+		zap.String("file", file),
+		zap.Strings("allowed_tools", entries))
+	return nil
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ToolValidator decides whether a command may run. env holds the request's
+// KEY=VALUE variables, without those the server adds. Returning a status
+// error chooses the code the client sees; other errors are reported as
+// PermissionDenied.
+type ToolValidator interface {
+	Validate(ctx context.Context, tool string, args []string, env []string) error
+}
+
+// ToolValidatorFunc adapts a function to a ToolValidator
+type ToolValidatorFunc func(ctx context.Context, tool string, args []string, env []string) error
+
+// Validate implements ToolValidator
+func (f ToolValidatorFunc) Validate(ctx context.Context, tool string, args []string, env []string) error {
+	return f(ctx, tool, args, env)
+}
+
+// allowlistValidator is the default validator, enforcing allowed_tools and
+// pinned tool versions
+type allowlistValidator struct {
+	s *ExecutorServer
+}
+
+// Validate implements ToolValidator
+func (v allowlistValidator) Validate(ctx context.Context, tool string, args []string, env []string) error {
+	if err := v.s.checkAllowed(tool); err != nil {
+		return err
+	}
+	return v.s.checkToolVersion(ctx, tool)
+}
+
+// checkAllowed rejects tools not in allowed_tools
+func (s *ExecutorServer) checkAllowed(tool string) error {
+	tools := s.tools.Load()
+	if !tools.Match(tool) {
+		return fieldError(codes.PermissionDenied, reasonToolNotAllowed, "tool", "tool '%s' is not allowed. Allowed tools: %v", tool, tools.Entries())
+	}
+	return nil
+}
+
+// validateTool runs req through the validators in order, rejecting it with
+// the first error
+func (s *ExecutorServer) validateTool(ctx context.Context, req *executorv1.ExecuteRequest) error {
+	for _, v := range s.validators {
+		err := v.Validate(ctx, req.Tool, req.Args, req.Env)
+		if err == nil {
+			continue
+		}
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return fieldError(codes.PermissionDenied, reasonToolNotAllowed, "tool", "tool '%s' was rejected: %v", req.Tool, err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+)
+
+// validateVerifyFiles checks that a step's verify_files stay inside its
+// working directory and are valid SHA-256 digests
+func validateVerifyFiles(step *executorv1.BuildStep) error {
+	for path, sum := range step.VerifyFiles {
+		if !filepath.IsLocal(path) {
+			return invalidField("verify_files", "verify_files path %s must be relative and inside work_dir", path)
+		}
+		if b, err := hex.DecodeString(sum); err != nil || len(b) != sha256.Size {
+			return invalidField("verify_files", "verify_files checksum for %s is not a SHA-256 hex digest", path)
+		}
+	}
+	return nil
+}
+
+// verifyFiles fails a successful response if any of the step's verify_files
+// is missing from dir or does not have its expected checksum
+func verifyFiles(step *executorv1.BuildStep, dir string, response *executorv1.ExecuteResponse) {
+	if len(step.VerifyFiles) == 0 || !response.Success {
+		return
+	}
+
+	paths := make([]string, 0, len(step.VerifyFiles))
+	for path := range step.VerifyFiles {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		sum, err := fileSHA256(filepath.Join(dir, path))
+		if err == nil && strings.EqualFold(sum, step.VerifyFiles[path]) {
+			continue
+		}
+
+		response.Success = false
+		response.ErrorCode = executorv1.ErrorCode_ERROR_CODE_CHECKSUM_MISMATCH
+		if err != nil {
+			response.Error = fmt.Sprintf("failed to verify %s: %v", path, err)
+		} else {
+			response.Error = fmt.Sprintf("checksum mismatch for %s: got %s, want %s", path, sum, step.VerifyFiles[path])
+		}
+		return
+	}
+}
+
+// fileSHA256 returns the hex SHA-256 digest of a file, read as a stream
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
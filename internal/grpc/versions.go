@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+// versionCheckTimeout bounds how long a tool's version command may run
+const versionCheckTimeout = 30 * time.Second
+
+// versionCheckRetry is how long a failed version check is cached before the
+// tool is checked again, e.g. after it was fixed or upgraded
+const versionCheckRetry = 30 * time.Second
+
+// versionCheck caches the outcome of verifying a tool's installed version.
+// mu is held while the check runs, so concurrent callers wait for it.
+type versionCheck struct {
+	mu        sync.Mutex
+	checked   bool
+	version   string
+	err       error
+	checkedAt time.Time
+}
+
+// checkToolVersion verifies the installed version of tool against
+// executor.tool_versions. A successful check is cached, a failed one for
+// versionCheckRetry.
+func (s *ExecutorServer) checkToolVersion(ctx context.Context, tool string) error {
+	_, err := s.toolVersion(ctx, tool)
+	return err
+}
+
+// toolVersion returns the verified version of a pinned tool, or "" if the
+// tool is not pinned
+func (s *ExecutorServer) toolVersion(ctx context.Context, tool string) (string, error) {
+	pin, ok := s.config.ToolVersions[strings.ToLower(filepath.Base(tool))]
+	if !ok {
+		return "", nil
+	}
+
+	entry, _ := s.versionChecks.LoadOrStore(versionKey(tool), &versionCheck{})
+	check := entry.(*versionCheck)
+	check.mu.Lock()
+	defer check.mu.Unlock()
+
+	if check.stale() {
+		check.version, check.err = s.runVersionCheck(ctx, tool, pin.Args, pin.Pattern)
+		check.checked = true
+		check.checkedAt = time.Now()
+	}
+	return check.version, check.err
+}
+
+// cachedToolVersion is toolVersion without running the version check:
+// checked is false for a pinned tool whose check is not cached or would be
+// retried
+func (s *ExecutorServer) cachedToolVersion(tool string) (version string, checked bool, err error) {
+	if _, ok := s.config.ToolVersions[strings.ToLower(filepath.Base(tool))]; !ok {
+		return "", true, nil
+	}
+
+	entry, ok := s.versionChecks.Load(versionKey(tool))
+	if !ok {
+		return "", false, nil
+	}
+	check := entry.(*versionCheck)
+	if !check.mu.TryLock() {
+		// A check is running right now
+		return "", false, nil
+	}
+	defer check.mu.Unlock()
+
+	if check.stale() {
+		return "", false, nil
+	}
+	return check.version, true, check.err
+}
+
+// stale reports whether the check has to run (again). check.mu must be
+// held.
+func (check *versionCheck) stale() bool {
+	return !check.checked || (check.err != nil && time.Since(check.checkedAt) >= versionCheckRetry)
+}
+
+// versionKey identifies the binary tool runs. Checks are cached per binary,
+// so "go" and "/usr/local/go/bin/go" share one while another go
+// installation gets its own.
+func versionKey(tool string) string {
+	if path, err := exec.LookPath(tool); err == nil {
+		if abs, err := filepath.Abs(path); err == nil {
+			return abs
+		}
+	}
+	return tool
+}
+
+// runVersionCheck runs the tool's version command and matches its output
+func (s *ExecutorServer) runVersionCheck(ctx context.Context, tool string, args []string, pattern string) (string, error) {
+	// Cached for every later caller, so don't let this request's cancellation decide it
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), versionCheckTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, tool, args...)
+	cmd.Env = s.environ(cmd)
+	output, err := cmd.CombinedOutput()
+	version := strings.TrimSpace(string(output))
+	if err != nil {
+		s.logger.Error("tool version check failed",
+			zap.String("tool", tool),
+			zap.Error(err))
+		return "", fieldError(codes.FailedPrecondition, reasonToolVersion, "tool", "could not determine version of tool '%s': %v", tool, err)
+	}
+
+	if !regexp.MustCompile(pattern).MatchString(version) {
+		s.logger.Error("tool version mismatch",
+			zap.String("tool", tool),
+			zap.String("version", version),
+			zap.String("expected", pattern))
+		return version, fieldError(codes.FailedPrecondition, reasonToolVersion, "tool", "tool '%s' version %q does not match required pattern %q", tool, version, pattern)
+	}
+
+	s.logger.Info("tool version verified",
+		zap.String("tool", tool),
+		zap.String("version", version))
+
+	return version, nil
+}
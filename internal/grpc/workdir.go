@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+// prepareWorkDir creates the request's working directory when asked to and
+// reports whether the server created it
+func (s *ExecutorServer) prepareWorkDir(req *executorv1.ExecuteRequest) (bool, error) {
+	if !req.CreateWorkDir || req.WorkDir == "" {
+		return false, nil
+	}
+
+	if _, err := os.Stat(req.WorkDir); err == nil {
+		return false, nil
+	} else if !os.IsNotExist(err) {
+		return false, fieldError(codes.FailedPrecondition, reasonWorkDir, "work_dir", "failed to stat working directory: %v", err)
+	}
+
+	if err := os.MkdirAll(req.WorkDir, 0o755); err != nil {
+		return false, fieldError(codes.FailedPrecondition, reasonWorkDir, "work_dir", "failed to create working directory: %v", err)
+	}
+
+	return true, nil
+}
+
+// checkWorkDir verifies that a request's working directory is an existing
+// directory, so the caller gets a clear error instead of a failed start
+func checkWorkDir(dir string) error {
+	if dir == "" {
+		return nil
+	}
+
+	info, err := os.Stat(dir)
+	switch {
+	case os.IsNotExist(err):
+		return invalidField("work_dir", "working directory %s does not exist (set create_work_dir to create it)", dir)
+	case err != nil:
+		return fieldError(codes.FailedPrecondition, reasonWorkDir, "work_dir", "failed to stat working directory: %v", err)
+	case !info.IsDir():
+		return invalidField("work_dir", "working directory %s is not a directory", dir)
+	}
+	return nil
+}
+
+// checkSuccessFile fails a successful response whose command did not create
+// the request's success_file
+func checkSuccessFile(req *executorv1.ExecuteRequest, response *executorv1.ExecuteResponse) {
+	if req.SuccessFile == "" || !response.Success {
+		return
+	}
+
+	if _, err := os.Stat(filepath.Join(req.WorkDir, req.SuccessFile)); err != nil {
+		response.Success = false
+		response.ErrorCode = executorv1.ErrorCode_ERROR_CODE_SUCCESS_MARKER_MISSING
+		response.Error = fmt.Sprintf("success file %s was not created", req.SuccessFile)
+	}
+}
+
+// cleanupWorkDir removes a server-created working directory if the cleanup
+// policy applies to the command's outcome
+func (s *ExecutorServer) cleanupWorkDir(dir string, policy executorv1.WorkDirCleanup, succeeded bool) {
+	switch policy {
+	case executorv1.WorkDirCleanup_WORK_DIR_CLEANUP_ALWAYS:
+	case executorv1.WorkDirCleanup_WORK_DIR_CLEANUP_ON_SUCCESS:
+		if !succeeded {
+			return
+		}
+	case executorv1.WorkDirCleanup_WORK_DIR_CLEANUP_ON_FAILURE:
+		if succeeded {
+			return
+		}
+	default:
+		return
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		s.logger.Warn("failed to clean up working directory",
+			zap.String("work_dir", dir),
+			zap.Error(err))
+		return
+	}
+
+	s.logger.Debug("cleaned up working directory",
+		zap.String("work_dir", dir),
+		zap.String("policy", policy.String()))
+}
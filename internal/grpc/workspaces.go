@@ -0,0 +1,218 @@
+package grpc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	executorv1 "github.com/knullci/necrosword/gen/executor/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// workspaceNamePattern restricts workspace names to one safe path element
+var workspaceNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9._-]*$`)
+
+// namedWorkspace is a reference-counted directory the server created under
+// workspace_base. Each lease and each execution using it holds a reference,
+// so releasing it never removes the directory from under a running command.
+type namedWorkspace struct {
+	path string
+	refs int32
+
+	// leases maps the lease IDs handed out by AcquireWorkspace to their
+	// expiry, zero if they never expire
+	leases map[string]time.Time
+}
+
+// workspaceKey identifies the workspace name of the caller's tenant
+func workspaceKey(ctx context.Context, name string) string {
+	return callerTenant(ctx) + "/" + name
+}
+
+// AcquireWorkspace creates the named workspace, or takes another lease on it
+// if it already exists. A directory the server did not create is never
+// taken over.
+func (s *ExecutorServer) AcquireWorkspace(ctx context.Context, req *executorv1.AcquireWorkspaceRequest) (*executorv1.AcquireWorkspaceResponse, error) {
+	if !workspaceNamePattern.MatchString(req.Name) {
+		return nil, invalidField("name", "invalid workspace name '%s'", req.Name)
+	}
+	key := workspaceKey(ctx, req.Name)
+
+	s.workspacesMu.Lock()
+	defer s.workspacesMu.Unlock()
+
+	now := time.Now()
+	s.expireLeasesLocked(now)
+	expiresAt := time.Time{}
+	if s.config.WorkspaceLeaseTTL > 0 {
+		expiresAt = now.Add(time.Duration(s.config.WorkspaceLeaseTTL) * time.Second)
+	}
+
+	if ws, ok := s.workspaces[key]; ok {
+		leaseID := req.LeaseId
+		if leaseID == "" {
+			leaseID = s.ids.newID()
+			ws.refs++
+		} else if _, held := ws.leases[leaseID]; !held {
+			return nil, status.Errorf(codes.NotFound, "lease %s on workspace '%s' not found", leaseID, req.Name)
+		}
+		ws.leases[leaseID] = expiresAt
+		return workspaceResponse(ws, leaseID, expiresAt, false), nil
+	}
+	if req.LeaseId != "" {
+		return nil, status.Errorf(codes.NotFound, "lease %s on workspace '%s' not found", req.LeaseId, req.Name)
+	}
+
+	base := s.config.WorkspaceBase
+	if tenant := callerTenant(ctx); tenant != "" {
+		base = filepath.Join(base, tenant)
+	}
+	path, err := filepath.Abs(filepath.Join(base, req.Name))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve workspace path: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create workspace: %v", err)
+	}
+	// Mkdir fails on an existing directory, which may belong to anyone
+	if err := os.Mkdir(path, 0o755); err != nil {
+		if os.IsExist(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "workspace directory %s exists and is not managed by the server", path)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create workspace: %v", err)
+	}
+
+	leaseID := s.ids.newID()
+	ws := &namedWorkspace{path: path, refs: 1, leases: map[string]time.Time{leaseID: expiresAt}}
+	s.workspaces[key] = ws
+	s.logger.Info("workspace acquired",
+		zap.String("name", key),
+		zap.String("path", path))
+
+	return workspaceResponse(ws, leaseID, expiresAt, true), nil
+}
+
+// workspaceResponse describes a lease on ws
+func workspaceResponse(ws *namedWorkspace, leaseID string, expiresAt time.Time, created bool) *executorv1.AcquireWorkspaceResponse {
+	response := &executorv1.AcquireWorkspaceResponse{
+		Path:       ws.path,
+		References: ws.refs,
+		Created:    created,
+		LeaseId:    leaseID,
+	}
+	if !expiresAt.IsZero() {
+		response.LeaseExpiresAt = timestamppb.New(expiresAt)
+	}
+	return response
+}
+
+// ReleaseWorkspace drops a lease on the named workspace
+func (s *ExecutorServer) ReleaseWorkspace(ctx context.Context, req *executorv1.ReleaseWorkspaceRequest) (*executorv1.ReleaseWorkspaceResponse, error) {
+	if req.LeaseId == "" {
+		return nil, invalidField("lease_id", "lease_id is required")
+	}
+	key := workspaceKey(ctx, req.Name)
+
+	s.workspacesMu.Lock()
+	defer s.workspacesMu.Unlock()
+
+	ws, ok := s.workspaces[key]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "workspace '%s' not found", req.Name)
+	}
+	if _, held := ws.leases[req.LeaseId]; !held {
+		return nil, status.Errorf(codes.NotFound, "lease %s on workspace '%s' not found", req.LeaseId, req.Name)
+	}
+
+	delete(ws.leases, req.LeaseId)
+	refs, removed := s.releaseWorkspaceLocked(key)
+	return &executorv1.ReleaseWorkspaceResponse{References: refs, Removed: removed}, nil
+}
+
+// expireWorkspaceLeases drops expired leases, e.g. of clients that died
+// without releasing them
+func (s *ExecutorServer) expireWorkspaceLeases() {
+	s.workspacesMu.Lock()
+	defer s.workspacesMu.Unlock()
+	s.expireLeasesLocked(time.Now())
+}
+
+// expireLeasesLocked drops the leases expired at now. s.workspacesMu must
+// be held.
+func (s *ExecutorServer) expireLeasesLocked(now time.Time) {
+	for key, ws := range s.workspaces {
+		for leaseID, expiresAt := range ws.leases {
+			if expiresAt.IsZero() || now.Before(expiresAt) {
+				continue
+			}
+			s.logger.Warn("workspace lease expired",
+				zap.String("name", key),
+				zap.String("lease_id", leaseID))
+			delete(ws.leases, leaseID)
+			s.releaseWorkspaceLocked(key)
+		}
+	}
+}
+
+// releaseWorkspaceLocked drops a reference to a workspace, removing its
+// directory once unreferenced. s.workspacesMu must be held.
+func (s *ExecutorServer) releaseWorkspaceLocked(key string) (int32, bool) {
+	ws := s.workspaces[key]
+	ws.refs--
+	if ws.refs > 0 {
+		return ws.refs, false
+	}
+
+	delete(s.workspaces, key)
+	if err := os.RemoveAll(ws.path); err != nil {
+		s.logger.Warn("failed to remove workspace",
+			zap.String("name", key),
+			zap.String("path", ws.path),
+			zap.Error(err))
+		return 0, false
+	}
+
+	s.logger.Info("workspace removed",
+		zap.String("name", key),
+		zap.String("path", ws.path))
+	return 0, true
+}
+
+// resolveWorkspace points a request naming a workspace at its directory and
+// holds a reference to it until release is called. Requests without a
+// workspace name are returned as they are.
+func (s *ExecutorServer) resolveWorkspace(ctx context.Context, req *executorv1.ExecuteRequest) (*executorv1.ExecuteRequest, func(), error) {
+	if req.WorkspaceName == "" {
+		return req, func() {}, nil
+	}
+	if !filepath.IsLocal(req.WorkDir) && req.WorkDir != "" {
+		return nil, nil, invalidField("work_dir", "work_dir must be a relative path inside the workspace when workspace_name is set")
+	}
+	key := workspaceKey(ctx, req.WorkspaceName)
+
+	s.workspacesMu.Lock()
+	defer s.workspacesMu.Unlock()
+
+	ws, ok := s.workspaces[key]
+	if !ok {
+		return nil, nil, fieldError(codes.FailedPrecondition, reasonWorkDir, "workspace_name", "workspace '%s' has not been acquired", req.WorkspaceName)
+	}
+	ws.refs++
+
+	resolved := proto.Clone(req).(*executorv1.ExecuteRequest)
+	resolved.WorkDir = filepath.Join(ws.path, req.WorkDir)
+	resolved.WorkspaceName = ""
+
+	release := func() {
+		s.workspacesMu.Lock()
+		defer s.workspacesMu.Unlock()
+		s.releaseWorkspaceLocked(key)
+	}
+	return resolved, release, nil
+}
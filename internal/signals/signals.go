@@ -0,0 +1,30 @@
+package signals
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"syscall"
+)
+
+// Parse resolves a signal name such as "SIGINT", "int" or "SIGUSR1" to a
+// signal that can be delivered to child processes
+func Parse(name string) (syscall.Signal, error) {
+	key := strings.ToUpper(strings.TrimSpace(name))
+	key = strings.TrimPrefix(key, "SIG")
+
+	if sig, ok := supported[key]; ok {
+		return sig, nil
+	}
+	return 0, fmt.Errorf("unsupported signal '%s'. Supported signals: %v", name, Names())
+}
+
+// Names returns the supported signal names in sorted order
+func Names() []string {
+	names := make([]string, 0, len(supported))
+	for name := range supported {
+		names = append(names, "SIG"+name)
+	}
+	sort.Strings(names)
+	return names
+}
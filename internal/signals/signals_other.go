@@ -0,0 +1,12 @@
+//go:build !unix
+
+package signals
+
+import "syscall"
+
+// supported maps signal names (without the SIG prefix) to signals
+var supported = map[string]syscall.Signal{
+	"INT":  syscall.SIGINT,
+	"KILL": syscall.SIGKILL,
+	"TERM": syscall.SIGTERM,
+}
@@ -0,0 +1,16 @@
+//go:build unix
+
+package signals
+
+import "syscall"
+
+// supported maps signal names (without the SIG prefix) to signals
+var supported = map[string]syscall.Signal{
+	"HUP":  syscall.SIGHUP,
+	"INT":  syscall.SIGINT,
+	"QUIT": syscall.SIGQUIT,
+	"KILL": syscall.SIGKILL,
+	"TERM": syscall.SIGTERM,
+	"USR1": syscall.SIGUSR1,
+	"USR2": syscall.SIGUSR2,
+}